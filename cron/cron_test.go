@@ -731,6 +731,8 @@ func TestStopAndWait(t *testing.T) {
 		cron.AddFunc("* * * * * *", func() { <-funcClock.After(OneSecond * 2) })
 		cron.AddFunc("* * * * * *", func() {})
 
+		// The snapshot is served once all the added entries are scheduled.
+		assert.Len(t, cron.Entries(), 3)
 		assert.Eventually(t, clock.HasWaiters, OneSecond, 10*time.Millisecond)
 		assert.False(t, funcClock.HasWaiters())
 		clock.Step(OneSecond)
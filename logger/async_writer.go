@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// asyncRecord is a write queued in an AsyncWriter, or a flush request if done
+// is set.
+type asyncRecord struct {
+	lvl  LogLevel
+	p    []byte
+	done chan struct{}
+	// err receives the error of a durable write, see writeDurable
+	err *error
+}
+
+// asyncDroppedTotal is the number of writes dropped by all the AsyncWriters.
+var asyncDroppedTotal atomic.Uint64
+
+// AsyncWriter queues the writes in a bounded buffer written to the underlying
+// writer by a background goroutine, so logging doesn't block on a slow
+// output. Writes are dropped while the buffer is full.
+type AsyncWriter struct {
+	w       io.Writer
+	queue   chan asyncRecord
+	dropped atomic.Uint64
+
+	lock   sync.RWMutex
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// NewAsyncWriter returns an AsyncWriter buffering up to size writes to w.
+// Closing the returned writer doesn't close w.
+func NewAsyncWriter(w io.Writer, size int) *AsyncWriter {
+	aw := &AsyncWriter{
+		w:     w,
+		queue: make(chan asyncRecord, max(size, 1)),
+	}
+
+	aw.wg.Add(1)
+	go func() {
+		defer aw.wg.Done()
+		for rec := range aw.queue {
+			if rec.p != nil {
+				err := aw.write(rec)
+				if rec.err != nil {
+					if err == nil {
+						err = syncWriter(aw.w)
+					}
+					*rec.err = err
+				}
+			}
+
+			if rec.done != nil {
+				close(rec.done)
+			}
+		}
+	}()
+
+	return aw
+}
+
+// Write queues p with the level Info, see WriteLevel.
+func (aw *AsyncWriter) Write(p []byte) (int, error) {
+	return aw.WriteLevel(InfoLevel, p)
+}
+
+// WriteLevel queues p, written with lvl if the underlying writer is a
+// LevelWriter. It drops p if the buffer is full, so it never blocks.
+// The write errors of the underlying writer are not reported.
+func (aw *AsyncWriter) WriteLevel(lvl LogLevel, p []byte) (int, error) {
+	aw.lock.RLock()
+	defer aw.lock.RUnlock()
+
+	if aw.closed {
+		return 0, ErrWriterClosed
+	}
+
+	select {
+	case aw.queue <- asyncRecord{lvl: lvl, p: append([]byte(nil), p...)}:
+	default:
+		aw.dropped.Add(1)
+		asyncDroppedTotal.Add(1)
+	}
+
+	return len(p), nil
+}
+
+// writeDurable queues p, blocking while the buffer is full, and waits until it
+// is written and synced to the underlying writer.
+func (aw *AsyncWriter) writeDurable(lvl LogLevel, p []byte) error {
+	aw.lock.RLock()
+	if aw.closed {
+		aw.lock.RUnlock()
+		return ErrWriterClosed
+	}
+
+	var err error
+	done := make(chan struct{})
+	aw.queue <- asyncRecord{lvl: lvl, p: append([]byte(nil), p...), done: done, err: &err}
+	aw.lock.RUnlock()
+
+	<-done
+
+	return err
+}
+
+func (aw *AsyncWriter) write(rec asyncRecord) error {
+	var err error
+	if lw, ok := aw.w.(LevelWriter); ok {
+		_, err = lw.WriteLevel(rec.lvl, rec.p)
+	} else {
+		_, err = aw.w.Write(rec.p)
+	}
+
+	return err
+}
+
+// Dropped returns the number of writes dropped because the buffer was full.
+func (aw *AsyncWriter) Dropped() uint64 {
+	return aw.dropped.Load()
+}
+
+// Flush blocks until the writes queued before it are written.
+func (aw *AsyncWriter) Flush() {
+	aw.lock.RLock()
+	if aw.closed {
+		aw.lock.RUnlock()
+		return
+	}
+
+	done := make(chan struct{})
+	aw.queue <- asyncRecord{done: done}
+	aw.lock.RUnlock()
+
+	<-done
+}
+
+// Close writes the queued writes and stops the background goroutine.
+func (aw *AsyncWriter) Close() error {
+	aw.lock.Lock()
+	if aw.closed {
+		aw.lock.Unlock()
+		return nil
+	}
+	aw.closed = true
+	close(aw.queue)
+	aw.lock.Unlock()
+
+	aw.wg.Wait()
+
+	return nil
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingWriter blocks the writes until unblocked.
+type blockingWriter struct {
+	syncBuffer
+	unblock chan struct{}
+	once    sync.Once
+}
+
+func newBlockingWriter() *blockingWriter {
+	return &blockingWriter{unblock: make(chan struct{})}
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	<-w.unblock
+	return w.syncBuffer.Write(p)
+}
+
+func (w *blockingWriter) release() {
+	w.once.Do(func() { close(w.unblock) })
+}
+
+type levelRecorder struct {
+	lock   sync.Mutex
+	levels []LogLevel
+}
+
+func (w *levelRecorder) Write(p []byte) (int, error) {
+	return w.WriteLevel(UndefinedLevel, p)
+}
+
+func (w *levelRecorder) WriteLevel(lvl LogLevel, p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.levels = append(w.levels, lvl)
+	return len(p), nil
+}
+
+func TestAsyncWriter(t *testing.T) {
+	t.Run("writes in the background", func(t *testing.T) {
+		dst := newBlockingWriter()
+		aw := NewAsyncWriter(dst, 4)
+		t.Cleanup(func() { aw.Close() })
+
+		n, err := aw.Write([]byte("first\n"))
+		require.NoError(t, err)
+		assert.Equal(t, len("first\n"), n)
+		assert.Empty(t, dst.String())
+
+		dst.release()
+		aw.Flush()
+		assert.Equal(t, "first\n", dst.String())
+	})
+
+	t.Run("drops writes when full", func(t *testing.T) {
+		total := AsyncDroppedTotal()
+
+		dst := newBlockingWriter()
+		aw := NewAsyncWriter(dst, 2)
+		t.Cleanup(func() { aw.Close() })
+
+		// The first write is taken by the background goroutine, blocked on dst,
+		// and the next two fill the buffer.
+		for range 10 {
+			_, err := aw.Write([]byte("x"))
+			require.NoError(t, err)
+		}
+
+		dropped := aw.Dropped()
+		assert.GreaterOrEqual(t, dropped, uint64(7))
+		assert.LessOrEqual(t, dropped, uint64(8))
+
+		dst.release()
+		aw.Flush()
+		assert.Len(t, dst.String(), 10-int(dropped))
+
+		// The total doesn't start over with the next writer.
+		require.NoError(t, aw.Close())
+		NewAsyncWriter(io.Discard, 1).Close()
+		assert.Equal(t, total+dropped, AsyncDroppedTotal())
+	})
+
+	t.Run("close writes the queued writes", func(t *testing.T) {
+		var dst syncBuffer
+		aw := NewAsyncWriter(&dst, 16)
+
+		for range 3 {
+			aw.Write([]byte("x"))
+		}
+		require.NoError(t, aw.Close())
+		assert.Equal(t, "xxx", dst.String())
+
+		_, err := aw.Write([]byte("x"))
+		require.ErrorIs(t, err, ErrWriterClosed)
+		aw.Flush()
+	})
+
+	t.Run("forwards the levels", func(t *testing.T) {
+		dst := &levelRecorder{}
+		aw := NewAsyncWriter(dst, 16)
+
+		var buf bytes.Buffer
+		testLogger := getTestLogger(&buf)
+		testLogger.SetOutput(aw)
+
+		testLogger.Error("failed")
+		testLogger.Info("done")
+		require.NoError(t, aw.Close())
+
+		assert.Equal(t, []LogLevel{ErrorLevel, InfoLevel}, dst.levels)
+	})
+}
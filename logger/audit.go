@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"syscall"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ErrNotSynced is returned by Audit and Auditf when the entry was written to
+// a writer without a Sync method, so it may not be on stable storage yet.
+var ErrNotSynced = errors.New("writer can't be synced")
+
+// SetAuditWriter sets the destination of the audit entries logged with Audit
+// and Auditf, such as a file opened in append mode. The entries are written to
+// the output if w is nil.
+func (l *daprLogger) SetAuditWriter(w io.Writer) {
+	l.core.lock.Lock()
+	defer l.core.lock.Unlock()
+
+	l.core.auditWriter = w
+}
+
+// Audit logs an entry with the audit log type at level Info, whatever the
+// output level. Unlike the other entries, it bypasses the sampling, rate
+// limiting, repeat suppression and async buffering, and it is synced to the
+// audit writer, or to the output, before Audit returns. Otherwise, it is
+// enriched, routed and exported like the other entries. It returns the error
+// if the entry couldn't be written or synced, see ErrNotSynced.
+func (l *daprLogger) Audit(args ...any) error {
+	return l.audit(fmt.Sprint(args...))
+}
+
+// Auditf logs an entry with the audit log type, see Audit.
+func (l *daprLogger) Auditf(format string, args ...any) error {
+	return l.audit(fmt.Sprintf(format, args...))
+}
+
+func (l *daprLogger) audit(msg string) error {
+	entry := l.logger.Dup()
+	entry.Time = l.core.clock.Now()
+	entry.Level = logrus.InfoLevel
+	entry.Message = msg
+	entry.Data[logFieldType] = LogTypeAudit
+
+	l.enrich(entry, false)
+
+	onWrite, n, err := l.writeLocked(entry, true)
+	l.notifyWrite(entry, onWrite, n, err)
+	if err != nil {
+		return fmt.Errorf("failed to write audit entry: %w", err)
+	}
+
+	return nil
+}
+
+// writeDurable writes serialized to w and syncs it, bypassing the buffer of
+// an AsyncWriter.
+func writeDurable(w io.Writer, entry *logrus.Entry, serialized []byte) (int, error) {
+	if aw, ok := w.(*AsyncWriter); ok {
+		if err := aw.writeDurable(fromLogrusLevel(entry.Level), serialized); err != nil {
+			return 0, err
+		}
+		return len(serialized), nil
+	}
+
+	n, err := writeOutput(w, entry, serialized)
+	if err != nil {
+		return n, err
+	}
+
+	return n, syncWriter(w)
+}
+
+// syncWriter commits the writes to w to stable storage, such as *os.File or
+// *RotatingFileWriter, and returns ErrNotSynced if w can't be synced.
+func syncWriter(w io.Writer) error {
+	s, ok := w.(interface{ Sync() error })
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrNotSynced, w)
+	}
+
+	// Pipes and terminals can't be synced.
+	if err := s.Sync(); err != nil && !errors.Is(err, syscall.EINVAL) && !errors.Is(err, syscall.ENOTSUP) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type syncRecorder struct {
+	bytes.Buffer
+	syncs int
+}
+
+func (s *syncRecorder) Sync() error {
+	s.syncs++
+	return nil
+}
+
+func TestAudit(t *testing.T) {
+	t.Run("written to the audit writer and synced", func(t *testing.T) {
+		var buf bytes.Buffer
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+		testLogger.SetOutputLevel(ErrorLevel)
+		// Sampling doesn't apply to the audit entries.
+		testLogger.SetSampling(0)
+
+		var audit syncRecorder
+		testLogger.SetAuditWriter(&audit)
+
+		require.NoError(t, testLogger.Auditf("user %s deleted secret", "alice"))
+		assert.Empty(t, buf.String())
+		assert.Equal(t, 1, audit.syncs)
+
+		var o map[string]any
+		require.NoError(t, json.Unmarshal(audit.Bytes(), &o))
+		assert.Equal(t, LogTypeAudit, o[logFieldType])
+		assert.Equal(t, "info", o[logFieldLevel])
+		assert.Equal(t, "user alice deleted secret", o[logFieldMessage])
+	})
+
+	t.Run("written to the output without an audit writer", func(t *testing.T) {
+		var buf bytes.Buffer
+		testLogger := getTestLogger(&buf)
+
+		// A bytes.Buffer can't be synced, but the entry is written.
+		require.ErrorIs(t, testLogger.Audit("audited"), ErrNotSynced)
+		assert.Contains(t, buf.String(), "audited")
+		assert.Contains(t, buf.String(), "type=audit")
+	})
+
+	t.Run("synced to a rotating file", func(t *testing.T) {
+		w, err := NewRotatingFileWriter(RotatingFileOptions{Filename: filepath.Join(t.TempDir(), "audit.log")})
+		require.NoError(t, err)
+		defer w.Close()
+
+		testLogger := getTestLogger(&bytes.Buffer{})
+		testLogger.SetAuditWriter(w)
+		require.NoError(t, testLogger.Audit("durable"))
+
+		b, err := os.ReadFile(w.opts.Filename)
+		require.NoError(t, err)
+		assert.Contains(t, string(b), "durable")
+	})
+
+	t.Run("enriched and routed like the other entries", func(t *testing.T) {
+		var buf syncRecorder
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+		testLogger.SetFieldKeyCase(CaseCamel)
+
+		var sink bytes.Buffer
+		testLogger.AddSink(&sink, SinkOptions{JSON: true})
+
+		var written []Entry
+		testLogger.OnWrite(func(e Entry, _ int, _ error) {
+			written = append(written, e)
+		})
+
+		require.NoError(t, testLogger.WithFields(map[string]any{"user_id": "alice"}).Audit("audited"))
+
+		o := readTestEntry(t, &buf.Buffer)
+		assert.Equal(t, DefaultSchemaVersion, o[logFieldSchemaVer])
+		assert.Equal(t, "alice", o["userId"])
+		assert.Contains(t, sink.String(), "audited")
+		require.Len(t, written, 1)
+		assert.Equal(t, "audited", written[0].Message)
+	})
+
+	t.Run("async output is bypassed", func(t *testing.T) {
+		f, err := os.Create(filepath.Join(t.TempDir(), "audit.log"))
+		require.NoError(t, err)
+		defer f.Close()
+
+		aw := NewAsyncWriter(f, 1)
+		defer aw.Close()
+
+		testLogger := getTestLogger(aw)
+		require.NoError(t, testLogger.Audit("durable"))
+
+		b, err := os.ReadFile(f.Name())
+		require.NoError(t, err)
+		assert.Contains(t, string(b), "durable")
+	})
+
+	t.Run("write errors are returned", func(t *testing.T) {
+		testLogger := getTestLogger(&bytes.Buffer{})
+		testLogger.SetAuditWriter(failingWriter{})
+
+		require.ErrorContains(t, testLogger.Audit("lost"), "disk full")
+	})
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"time"
+)
+
+const (
+	logFieldAttempt         = "attempt"
+	logFieldBackoffBaseMs   = "backoff_base_ms"
+	logFieldBackoffActualMs = "backoff_actual_ms"
+)
+
+// WithBackoff returns a logger with the retry attempt number, the base backoff
+// and the backoff actually waited after applying jitter, both in milliseconds.
+func (l *daprLogger) WithBackoff(attempt int, base, jittered time.Duration) Logger {
+	return l.WithFields(map[string]any{
+		logFieldAttempt:         attempt,
+		logFieldBackoffBaseMs:   toMilliseconds(base),
+		logFieldBackoffActualMs: toMilliseconds(jittered),
+	})
+}
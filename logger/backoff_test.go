@@ -0,0 +1,37 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithBackoff(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	testLogger.WithBackoff(3, 400*time.Millisecond, 412500*time.Microsecond).Info("retrying")
+
+	o := readTestEntry(t, &buf)
+
+	assert.InDelta(t, float64(3), o[logFieldAttempt], 0)
+	assert.InDelta(t, float64(400), o[logFieldBackoffBaseMs], 0)
+	assert.InDelta(t, 412.5, o[logFieldBackoffActualMs], 0)
+}
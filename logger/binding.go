@@ -0,0 +1,49 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"time"
+)
+
+const (
+	logFieldBindingName      = "binding_name"
+	logFieldBindingOperation = "binding_operation"
+	logFieldBindingSuccess   = "binding_success"
+)
+
+// WithBinding returns a logger with the binding_name and binding_operation
+// fields.
+func (l *daprLogger) WithBinding(name, operation string) Logger {
+	return l.WithFields(map[string]any{
+		logFieldBindingName:      name,
+		logFieldBindingOperation: operation,
+	})
+}
+
+// LogBindingResult logs the outcome of a binding invocation, usually on a
+// logger returned by WithBinding.
+// Successful invocations are logged at level Debug, failures at level Error.
+func (l *daprLogger) LogBindingResult(success bool, err error, duration time.Duration) {
+	fields := map[string]any{
+		logFieldBindingSuccess: success,
+		logFieldDurationMs:     toMilliseconds(duration),
+	}
+	if !success {
+		l.WithError(err).WithFields(fields).Error("Binding invocation failed")
+		return
+	}
+
+	l.WithFields(fields).Debug("Binding invocation succeeded")
+}
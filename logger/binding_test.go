@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBindingLogs(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.SetOutputLevel(DebugLevel)
+
+	bindingLogger := testLogger.WithBinding("kafka-orders", "create")
+
+	t.Run("success", func(t *testing.T) {
+		bindingLogger.LogBindingResult(true, nil, 20*time.Millisecond)
+
+		o := readTestEntry(t, &buf)
+
+		assert.Equal(t, "debug", o[logFieldLevel])
+		assert.Equal(t, "kafka-orders", o[logFieldBindingName])
+		assert.Equal(t, "create", o[logFieldBindingOperation])
+		assert.Equal(t, true, o[logFieldBindingSuccess])
+		assert.InDelta(t, float64(20), o[logFieldDurationMs], 0.001)
+		assert.NotContains(t, o, logFieldError)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		bindingLogger.LogBindingResult(false, errors.New("broker unavailable"), time.Second)
+
+		o := readTestEntry(t, &buf)
+
+		assert.Equal(t, "error", o[logFieldLevel])
+		assert.Equal(t, "kafka-orders", o[logFieldBindingName])
+		assert.Equal(t, "create", o[logFieldBindingOperation])
+		assert.Equal(t, false, o[logFieldBindingSuccess])
+		assert.Equal(t, "broker unavailable", o[logFieldError])
+	})
+
+	t.Run("parent logger has no binding fields", func(t *testing.T) {
+		testLogger.Info("plain")
+
+		o := readTestEntry(t, &buf)
+
+		assert.NotContains(t, o, logFieldBindingName)
+	})
+}
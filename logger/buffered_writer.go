@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	kclock "k8s.io/utils/clock"
+)
+
+// ErrWriterClosed is returned when writing to a closed writer.
+var ErrWriterClosed = errors.New("writer is closed")
+
+// bufferedWriter buffers writes in memory up to a maximum size.
+type bufferedWriter struct {
+	lock    sync.Mutex
+	buf     *bufio.Writer
+	closed  bool
+	closeCh chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewBufferedWriter returns a writer buffering up to size bytes before writing
+// them to w. Writes block while the buffer is flushed once it's full.
+// The buffer is also flushed every flushInterval, if greater than zero, and on
+// Close. Closing the returned writer doesn't close w.
+func NewBufferedWriter(w io.Writer, size int, flushInterval time.Duration) io.WriteCloser {
+	return newBufferedWriter(w, size, flushInterval, kclock.RealClock{})
+}
+
+func newBufferedWriter(w io.Writer, size int, flushInterval time.Duration, clock kclock.WithTicker) *bufferedWriter {
+	bw := &bufferedWriter{
+		buf:     bufio.NewWriterSize(w, size),
+		closeCh: make(chan struct{}),
+	}
+
+	if flushInterval > 0 {
+		ticker := clock.NewTicker(flushInterval)
+		bw.wg.Add(1)
+		go func() {
+			defer bw.wg.Done()
+			defer ticker.Stop()
+			for {
+				select {
+				case <-bw.closeCh:
+					return
+				case <-ticker.C():
+					bw.lock.Lock()
+					_ = bw.buf.Flush()
+					bw.lock.Unlock()
+				}
+			}
+		}()
+	}
+
+	return bw
+}
+
+// Write buffers p, flushing the buffer when p doesn't fit in it.
+func (bw *bufferedWriter) Write(p []byte) (int, error) {
+	bw.lock.Lock()
+	defer bw.lock.Unlock()
+
+	if bw.closed {
+		return 0, ErrWriterClosed
+	}
+
+	return bw.buf.Write(p)
+}
+
+// Close stops the periodic flush and flushes the buffered data.
+func (bw *bufferedWriter) Close() error {
+	bw.lock.Lock()
+	if bw.closed {
+		bw.lock.Unlock()
+		return nil
+	}
+	bw.closed = true
+	close(bw.closeCh)
+	bw.lock.Unlock()
+
+	bw.wg.Wait()
+
+	bw.lock.Lock()
+	defer bw.lock.Unlock()
+
+	return bw.buf.Flush()
+}
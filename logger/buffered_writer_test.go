@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+// syncBuffer is a bytes.Buffer safe for concurrent use.
+type syncBuffer struct {
+	lock sync.Mutex
+	buf  bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buf.String()
+}
+
+func TestBufferedWriter(t *testing.T) {
+	t.Run("flush on size", func(t *testing.T) {
+		var dst syncBuffer
+
+		bw := NewBufferedWriter(&dst, 16, 0)
+
+		_, err := bw.Write([]byte("0123456789"))
+		require.NoError(t, err)
+		assert.Empty(t, dst.String())
+
+		_, err = bw.Write([]byte("abcdefghij"))
+		require.NoError(t, err)
+		assert.Equal(t, "0123456789abcdef", dst.String())
+
+		require.NoError(t, bw.Close())
+		assert.Equal(t, "0123456789abcdefghij", dst.String())
+	})
+
+	t.Run("flush on interval", func(t *testing.T) {
+		var dst syncBuffer
+
+		clock := clocktesting.NewFakeClock(time.Now())
+		bw := newBufferedWriter(&dst, 1024, time.Second, clock)
+		t.Cleanup(func() { bw.Close() })
+
+		_, err := bw.Write([]byte("hello"))
+		require.NoError(t, err)
+		assert.Empty(t, dst.String())
+
+		assert.Eventually(t, clock.HasWaiters, time.Second, time.Millisecond)
+		clock.Step(time.Second)
+
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			assert.Equal(c, "hello", dst.String())
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("flush on close", func(t *testing.T) {
+		var dst syncBuffer
+
+		bw := NewBufferedWriter(&dst, 1024, time.Hour)
+
+		_, err := bw.Write([]byte("hello"))
+		require.NoError(t, err)
+		assert.Empty(t, dst.String())
+
+		require.NoError(t, bw.Close())
+		assert.Equal(t, "hello", dst.String())
+
+		_, err = bw.Write([]byte("world"))
+		require.ErrorIs(t, err, ErrWriterClosed)
+		require.NoError(t, bw.Close())
+	})
+
+	t.Run("as logger output", func(t *testing.T) {
+		var dst syncBuffer
+
+		bw := NewBufferedWriter(&dst, 4096, 0)
+		testLogger := getTestLogger(bw)
+
+		testLogger.Info("buffered")
+		assert.Empty(t, dst.String())
+
+		require.NoError(t, bw.Close())
+		assert.Contains(t, dst.String(), `msg=buffered`)
+	})
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	logFieldCaller = "caller"
+	logFieldFunc   = "func"
+
+	// callerMaxDepth is the number of frames searched for the caller.
+	callerMaxDepth = 32
+
+	// logrPackage is the import path of logr, whose frames are skipped.
+	logrPackage = "github.com/go-logr/logr"
+)
+
+// grpclogPackages are the import path prefixes of the gRPC logging wrappers,
+// grpclog and its internal packages, whose frames are skipped.
+var grpclogPackages = []string{
+	"google.golang.org/grpc/grpclog.",
+	"google.golang.org/grpc/grpclog/",
+	"google.golang.org/grpc/internal/grpclog.",
+}
+
+// loggerPackage is the import path of this package, whose frames are skipped
+// when looking for the caller.
+var loggerPackage = func() string {
+	pc, _, _, _ := runtime.Caller(0)
+	name := runtime.FuncForPC(pc).Name()
+	slash := strings.LastIndex(name, "/")
+	return name[:slash+strings.Index(name[slash:], ".")]
+}()
+
+// EnableCaller enables adding the file and line the entries are logged from
+// in the caller field, such as dapr_logger.go:123, and the function in the
+// func field. The frames of this package are skipped, so the fields point at
+// the code calling the logger.
+func (l *daprLogger) EnableCaller(enabled bool) {
+	l.core.caller.Store(enabled)
+}
+
+// addCaller adds the caller fields of the first frame outside of this package
+// and log/slog to data.
+func addCaller(data logrus.Fields) {
+	pcs := make([]uintptr, callerMaxDepth)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+
+	for {
+		frame, more := frames.Next()
+		if !isLoggerFrame(frame) {
+			data[logFieldCaller] = filepath.Base(frame.File) + ":" + strconv.Itoa(frame.Line)
+			data[logFieldFunc] = frame.Function[strings.LastIndex(frame.Function, "/")+1:]
+			return
+		}
+
+		if !more {
+			return
+		}
+	}
+}
+
+// isLoggerFrame returns true if frame is in the wrapper code of this package,
+// of log/slog, whose records are handled by the slog handler, of log, whose
+// writes are handled by the StdLogger writer, of logr, whose calls are
+// handled by the NewLogr sink, or of grpclog, whose calls are handled by the
+// NewGRPCLoggerV2 logger.
+func isLoggerFrame(frame runtime.Frame) bool {
+	fn := frame.Function
+	if strings.HasPrefix(fn, "log/slog.") || strings.HasPrefix(fn, "log.") || strings.HasPrefix(fn, logrPackage+".") {
+		return true
+	}
+
+	for _, pkg := range grpclogPackages {
+		if strings.HasPrefix(fn, pkg) {
+			return true
+		}
+	}
+
+	// Tests of this package log like any other caller.
+	if strings.HasSuffix(frame.File, "_test.go") {
+		return false
+	}
+
+	return strings.HasPrefix(fn, loggerPackage+".")
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"log/slog"
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnableCaller(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.SetOutputLevel(DebugLevel)
+
+	// line returns the caller_test.go:line of the line before the call.
+	line := func() string {
+		_, _, l, _ := runtime.Caller(1)
+		return "caller_test.go:" + strconv.Itoa(l-1)
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		testLogger.Info("no caller")
+
+		o := readTestEntry(t, &buf)
+		assert.NotContains(t, o, logFieldCaller)
+		assert.NotContains(t, o, logFieldFunc)
+	})
+
+	testLogger.EnableCaller(true)
+
+	t.Run("direct call", func(t *testing.T) {
+		testLogger.Info("with caller")
+		want := line()
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, want, o[logFieldCaller])
+		assert.Contains(t, o[logFieldFunc], "logger.TestEnableCaller.")
+	})
+
+	t.Run("derived logger", func(t *testing.T) {
+		testLogger.WithFields(map[string]any{"a": 1}).WithLogType(LogTypeRequest).Warnf("with %s", "caller")
+		want := line()
+
+		assert.Equal(t, want, readTestEntry(t, &buf)[logFieldCaller])
+	})
+
+	t.Run("helper method", func(t *testing.T) {
+		testLogger.LogEviction("actors", "key", EvictionReasonTTL)
+		want := line()
+
+		assert.Equal(t, want, readTestEntry(t, &buf)[logFieldCaller])
+	})
+
+	t.Run("slog handler", func(t *testing.T) {
+		slog.New(NewSlogHandler(testLogger)).Info("from slog")
+		want := line()
+
+		assert.Equal(t, want, readTestEntry(t, &buf)[logFieldCaller])
+	})
+
+	t.Run("disabled again", func(t *testing.T) {
+		testLogger.EnableCaller(false)
+		testLogger.Info("no caller")
+
+		assert.NotContains(t, readTestEntry(t, &buf), logFieldCaller)
+	})
+}
+
+func TestIsLoggerFrame(t *testing.T) {
+	tests := map[string]bool{
+		"log/slog.(*Logger).Info":                                          true,
+		"github.com/go-logr/logr.Logger.Info":                              true,
+		"google.golang.org/grpc/grpclog.(*componentData).InfoDepth":        true,
+		"google.golang.org/grpc/grpclog.WarningDepth":                      true,
+		"google.golang.org/grpc/grpclog/internal.(*loggerWrapper).Info":    true,
+		"google.golang.org/grpc/internal/grpclog.(*PrefixLogger).Warningf": true,
+		"google.golang.org/grpc/internal/transport.(*http2Client).Close":   false,
+		"google.golang.org/grpc.(*ClientConn).Close":                       false,
+		"github.com/dapr/dapr/pkg/runtime.(*DaprRuntime).Run":              false,
+	}
+
+	for fn, want := range tests {
+		t.Run(fn, func(t *testing.T) {
+			assert.Equal(t, want, isLoggerFrame(runtime.Frame{Function: fn, File: "file.go"}))
+		})
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"math"
+	"math/bits"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	logFieldCardinalityKey       = "cardinality_key"
+	logFieldCardinalityEstimate  = "cardinality_estimate"
+	logFieldCardinalityThreshold = "cardinality_threshold"
+)
+
+// hllPrecision is the number of bits of the hash indexing the HyperLogLog
+// registers, for a standard error of about 3%.
+const hllPrecision = 10
+
+// hyperLogLog estimates the number of distinct values added to it.
+type hyperLogLog struct {
+	lock      sync.Mutex
+	registers [1 << hllPrecision]uint8
+}
+
+func (h *hyperLogLog) add(v any) {
+	f := fnv.New64a()
+	fmt.Fprint(f, v)
+	x := mix64(f.Sum64())
+
+	idx := x >> (64 - hllPrecision)
+	rank := uint8(bits.LeadingZeros64(x<<hllPrecision|1<<(hllPrecision-1))) + 1 //nolint:gosec
+
+	h.lock.Lock()
+	if rank > h.registers[idx] {
+		h.registers[idx] = rank
+	}
+	h.lock.Unlock()
+}
+
+func (h *hyperLogLog) estimate() uint64 {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	const m = float64(len(h.registers))
+
+	var (
+		sum   float64
+		zeros int
+	)
+	for _, r := range h.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	e := 0.7213 / (1 + 1.079/m) * m * m / sum
+	// Use linear counting for small cardinalities.
+	if e <= 2.5*m && zeros > 0 {
+		e = m * math.Log(m/float64(zeros))
+	}
+
+	return uint64(math.Round(e))
+}
+
+// mix64 spreads the bits of FNV hashes, whose high bits vary little for
+// similar values.
+func mix64(x uint64) uint64 {
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
+
+// TrackFieldCardinality starts estimating the number of distinct values of
+// the field key in the entries written, for LogCardinalityWarning.
+// The estimate uses a constant 1KiB of memory per field, whatever its number
+// of distinct values.
+func (l *daprLogger) TrackFieldCardinality(key string) {
+	l.core.lock.Lock()
+	defer l.core.lock.Unlock()
+
+	tracked := make(map[string]*hyperLogLog, 1)
+	if cur := l.core.cardinality.Load(); cur != nil {
+		if _, ok := (*cur)[key]; ok {
+			return
+		}
+		tracked = maps.Clone(*cur)
+	}
+	tracked[key] = &hyperLogLog{}
+
+	l.core.cardinality.Store(&tracked)
+}
+
+// LogCardinalityWarning logs at level Warn when the estimated number of
+// distinct values of the field key exceeds threshold. Nothing is logged
+// otherwise, or if the field isn't tracked with TrackFieldCardinality.
+func (l *daprLogger) LogCardinalityWarning(key string, threshold int) {
+	tracked := l.core.cardinality.Load()
+	if tracked == nil {
+		return
+	}
+	h, ok := (*tracked)[key]
+	if !ok {
+		return
+	}
+
+	estimate := h.estimate()
+	if estimate <= uint64(max(threshold, 0)) { //nolint:gosec
+		return
+	}
+
+	l.WithFields(map[string]any{
+		logFieldCardinalityKey:       key,
+		logFieldCardinalityEstimate:  estimate,
+		logFieldCardinalityThreshold: threshold,
+	}).Warnf("Field %s has about %d distinct values, over the threshold of %d", key, estimate, threshold)
+}
+
+// observeCardinality adds the values of the tracked fields in data.
+func (l *daprLogger) observeCardinality(data logrus.Fields) {
+	tracked := l.core.cardinality.Load()
+	if tracked == nil {
+		return
+	}
+
+	for key, h := range *tracked {
+		if v, ok := data[key]; ok {
+			h.add(v)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldCardinality(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.TrackFieldCardinality("actor_id")
+
+	logActors := func(from, to int) {
+		for i := from; i < to; i++ {
+			testLogger.WithFields(map[string]any{"actor_id": "actor-" + strconv.Itoa(i)}).Info("invoked")
+		}
+		buf.Reset()
+	}
+
+	estimate := func() uint64 {
+		return (*testLogger.core.cardinality.Load())["actor_id"].estimate()
+	}
+
+	t.Run("estimate grows", func(t *testing.T) {
+		logActors(0, 100)
+		small := estimate()
+		assert.InDelta(t, 100, small, 10)
+
+		// Repeated values don't change the estimate.
+		logActors(0, 100)
+		assert.Equal(t, small, estimate())
+
+		logActors(100, 5000)
+		assert.InDelta(t, 5000, estimate(), 5000*0.1)
+	})
+
+	t.Run("below threshold", func(t *testing.T) {
+		testLogger.LogCardinalityWarning("actor_id", 10000)
+		assert.Zero(t, buf.Len())
+	})
+
+	t.Run("past threshold", func(t *testing.T) {
+		testLogger.LogCardinalityWarning("actor_id", 1000)
+
+		o := readTestEntry(t, &buf)
+		buf.Reset()
+
+		assert.Equal(t, "warning", o[logFieldLevel])
+		assert.Equal(t, "actor_id", o[logFieldCardinalityKey])
+		assert.Greater(t, o[logFieldCardinalityEstimate], float64(1000))
+		assert.InDelta(t, float64(1000), o[logFieldCardinalityThreshold], 0)
+	})
+
+	t.Run("untracked field", func(t *testing.T) {
+		testLogger.LogCardinalityWarning("app_id", 0)
+		assert.Zero(t, buf.Len())
+	})
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// cborEncMode encodes the map keys in a deterministic order.
+	cborEncMode, _ = cbor.CoreDetEncOptions().EncMode()
+	// cborDecMode decodes the CBOR maps as JSON objects.
+	cborDecMode, _ = cbor.DecOptions{DefaultMapType: reflect.TypeFor[map[string]any]()}.DecMode()
+)
+
+// cborFormatter formats the entries with the Dapr layout, as a sequence of
+// CBOR maps.
+type cborFormatter struct {
+	timestampFormat string
+	// jsonEnabled is the JSON output setting, restored when the encoding is
+	// set back to OutputEncodingText
+	jsonEnabled bool
+}
+
+// Format implements logrus.Formatter.
+func (f *cborFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	data := make(map[string]any, len(entry.Data)+3)
+	for k, v := range entry.Data {
+		data[k] = jsonFieldValue(v)
+	}
+
+	data[logFieldTimeStamp] = entry.Time.Format(f.timestampFormat)
+	data[logFieldLevel] = entry.Level.String()
+	data[logFieldMessage] = entry.Message
+
+	b, err := cborEncMode.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal fields to CBOR, %w", err)
+	}
+
+	return b, nil
+}
+
+// DecodeCBOREntries decodes the entries written with OutputEncodingCBOR, such as in
+// tests, returning their fields.
+func DecodeCBOREntries(r io.Reader) ([]map[string]any, error) {
+	dec := cborDecMode.NewDecoder(r)
+
+	var entries []map[string]any
+	for {
+		var fields map[string]any
+		if err := dec.Decode(&fields); err != nil {
+			if errors.Is(err, io.EOF) {
+				return entries, nil
+			}
+			return entries, fmt.Errorf("failed to decode CBOR entry: %w", err)
+		}
+		entries = append(entries, fields)
+	}
+}
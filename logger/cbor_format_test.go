@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestCBORFormat(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	testLogger := getTestLogger(&buf)
+	testLogger.core.clock = clocktesting.NewFakeClock(now)
+	testLogger.SetOutputEncoding(OutputEncodingCBOR)
+
+	testLogger.WithFields(map[string]any{
+		"count":  3,
+		"nested": map[string]any{"ok": true},
+	}).Info("first")
+	testLogger.WithError(errors.New("boom")).Error("second")
+
+	entries, err := DecodeCBOREntries(&buf)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, "first", entries[0][logFieldMessage])
+	assert.Equal(t, "info", entries[0][logFieldLevel])
+	assert.Equal(t, "2026-03-01T12:30:00Z", entries[0][logFieldTimeStamp])
+	assert.Equal(t, fakeLoggerName, entries[0][logFieldScope])
+	assert.EqualValues(t, 3, entries[0]["count"])
+	assert.Equal(t, map[string]any{"ok": true}, entries[0]["nested"])
+
+	assert.Equal(t, "second", entries[1][logFieldMessage])
+	assert.Equal(t, "boom", entries[1][logFieldError])
+
+	t.Run("invalid input", func(t *testing.T) {
+		_, err := DecodeCBOREntries(bytes.NewReader([]byte{0xff}))
+		require.Error(t, err)
+	})
+}
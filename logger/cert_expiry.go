@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"time"
+)
+
+const (
+	logFieldCertSubject    = "cert_subject"
+	logFieldExpiresAt      = "expires_at"
+	logFieldExpiresInHours = "expires_in_hours"
+)
+
+// LogCertExpiry logs the expiry of the certificate with subject, at level
+// Warn when it expires within warnWithin, or has already expired, and at level
+// Debug otherwise.
+func (l *daprLogger) LogCertExpiry(subject string, notAfter time.Time, warnWithin time.Duration) {
+	expiresIn := notAfter.Sub(l.core.clock.Now())
+
+	log := l.WithFields(map[string]any{
+		logFieldCertSubject:    subject,
+		logFieldExpiresAt:      notAfter.UTC().Format(time.RFC3339),
+		logFieldExpiresInHours: expiresIn.Hours(),
+	})
+
+	switch {
+	case expiresIn <= 0:
+		log.Warnf("Certificate %s has expired", subject)
+	case expiresIn <= warnWithin:
+		log.Warnf("Certificate %s expires in %s", subject, expiresIn.Round(time.Minute))
+	default:
+		log.Debugf("Certificate %s expires in %s", subject, expiresIn.Round(time.Minute))
+	}
+}
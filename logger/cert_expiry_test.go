@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestLogCertExpiry(t *testing.T) {
+	var buf bytes.Buffer
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.SetOutputLevel(DebugLevel)
+	testLogger.core.clock = clocktesting.NewFakeClock(now)
+
+	t.Run("near expiry", func(t *testing.T) {
+		testLogger.LogCertExpiry("CN=sentry", now.Add(36*time.Hour), 72*time.Hour)
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "warning", o[logFieldLevel])
+		assert.Equal(t, "CN=sentry", o[logFieldCertSubject])
+		assert.Equal(t, "2026-03-03T00:00:00Z", o[logFieldExpiresAt])
+		assert.InDelta(t, float64(36), o[logFieldExpiresInHours], 0)
+	})
+
+	t.Run("far expiry", func(t *testing.T) {
+		testLogger.LogCertExpiry("CN=sentry", now.Add(30*24*time.Hour), 72*time.Hour)
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "debug", o[logFieldLevel])
+		assert.InDelta(t, float64(720), o[logFieldExpiresInHours], 0)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		testLogger.LogCertExpiry("CN=sentry", now.Add(-2*time.Hour), 72*time.Hour)
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "warning", o[logFieldLevel])
+		assert.InDelta(t, float64(-2), o[logFieldExpiresInHours], 0)
+		assert.Contains(t, o[logFieldMessage], "has expired")
+	})
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"io"
+	"maps"
+
+	"github.com/sirupsen/logrus"
+)
+
+const logFieldChannel = "channel"
+
+// channel is an output with its own level, defined with DefineChannel.
+type channel struct {
+	w     io.Writer
+	level logrus.Level
+}
+
+// DefineChannel defines the channel name, whose entries are written to w when
+// they are at least as severe as level, independently of the logger output
+// and output level. Defining an existing channel replaces it.
+func (l *daprLogger) DefineChannel(name string, w io.Writer, level LogLevel) {
+	l.core.lock.Lock()
+	defer l.core.lock.Unlock()
+
+	channels := make(map[string]channel, 1)
+	if cur := l.core.channels.Load(); cur != nil {
+		channels = maps.Clone(*cur)
+	}
+	channels[name] = channel{
+		w:     w,
+		level: toLogrusLevel(level),
+	}
+
+	l.core.channels.Store(&channels)
+}
+
+// ToChannel returns a logger whose entries carry name in the channel field,
+// and are filtered and written by the channel. Until the channel is defined,
+// its entries go to the logger output.
+func (l *daprLogger) ToChannel(name string) Logger {
+	dl := l.derive(l.logger.WithField(logFieldChannel, name))
+	dl.channel = name
+
+	return dl
+}
+
+// lookupChannel returns the channel name, if defined.
+func (l *daprLogger) lookupChannel(name string) (channel, bool) {
+	channels := l.core.channels.Load()
+	if channels == nil {
+		return channel{}, false
+	}
+
+	ch, ok := (*channels)[name]
+	return ch, ok
+}
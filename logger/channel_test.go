@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannels(t *testing.T) {
+	var buf, audit, access bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.SetOutputLevel(InfoLevel)
+
+	testLogger.DefineChannel("audit", &audit, DebugLevel)
+	testLogger.DefineChannel("access", &access, WarnLevel)
+
+	auditLogger := testLogger.ToChannel("audit")
+	accessLogger := testLogger.ToChannel("access")
+
+	t.Run("levels per channel", func(t *testing.T) {
+		assert.True(t, auditLogger.IsOutputLevelEnabled(DebugLevel))
+		assert.False(t, accessLogger.IsOutputLevelEnabled(InfoLevel))
+		assert.True(t, accessLogger.IsOutputLevelEnabled(WarnLevel))
+		assert.False(t, testLogger.IsOutputLevelEnabled(DebugLevel))
+	})
+
+	t.Run("entries routed per channel", func(t *testing.T) {
+		auditLogger.Debug("audit debug")
+		auditLogger.Info("audit info")
+		accessLogger.Info("access info")
+		accessLogger.WithFields(map[string]any{"status": 503}).Warn("access warn")
+		testLogger.Debug("app debug")
+		testLogger.Info("app info")
+
+		messages := func(b *bytes.Buffer) []string {
+			var msgs []string
+			for _, line := range strings.Split(strings.TrimSpace(b.String()), "\n") {
+				var o map[string]any
+				require.NoError(t, json.Unmarshal([]byte(line), &o))
+				msgs = append(msgs, o[logFieldMessage].(string))
+			}
+			return msgs
+		}
+
+		assert.Equal(t, []string{"audit debug", "audit info"}, messages(&audit))
+		assert.Equal(t, []string{"access warn"}, messages(&access))
+		assert.Equal(t, []string{"app info"}, messages(&buf))
+
+		var o map[string]any
+		require.NoError(t, json.Unmarshal(access.Bytes(), &o))
+		assert.Equal(t, "access", o[logFieldChannel])
+		assert.InDelta(t, float64(503), o["status"], 0)
+		buf.Reset()
+	})
+
+	t.Run("undefined channel", func(t *testing.T) {
+		testLogger.ToChannel("metrics").Info("metrics info")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "metrics", o[logFieldChannel])
+		assert.Equal(t, "metrics info", o[logFieldMessage])
+	})
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// The reified properties of CLEF, see https://clef-json.org.
+const (
+	clefFieldTimestamp = "@t"
+	clefFieldMessage   = "@m"
+	clefFieldLevel     = "@l"
+	clefFieldException = "@x"
+	clefFieldTraceID   = "@tr"
+	clefFieldSpanID    = "@sp"
+)
+
+// clefFormatter formats the entries as CLEF, the compact log event format of
+// Serilog, for Seq. The fields, including scope and app_id, are written as
+// properties.
+type clefFormatter struct {
+	timestampFormat string
+}
+
+// Format implements logrus.Formatter.
+func (f *clefFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	data := make(map[string]any, len(entry.Data)+3)
+	for k, v := range entry.Data {
+		// The properties starting with @ are escaped by doubling it.
+		if strings.HasPrefix(k, "@") {
+			k = "@" + k
+		}
+		data[k] = jsonFieldValue(v)
+	}
+
+	if err, ok := data[logFieldError].(string); ok {
+		if stack, ok := data[logFieldStackTrace].(string); ok {
+			err += "\n" + stack
+			delete(data, logFieldStackTrace)
+		}
+		data[clefFieldException] = err
+		delete(data, logFieldError)
+	}
+	if traceID, ok := data[logFieldTraceID]; ok {
+		data[clefFieldTraceID] = traceID
+		delete(data, logFieldTraceID)
+	}
+	if spanID, ok := data[logFieldSpanID]; ok {
+		data[clefFieldSpanID] = spanID
+		delete(data, logFieldSpanID)
+	}
+
+	data[clefFieldTimestamp] = entry.Time.Format(f.timestampFormat)
+	data[clefFieldMessage] = entry.Message
+	// Information is the level of the events without @l.
+	if lvl := clefLevel(fromLogrusLevel(entry.Level)); lvl != "Information" {
+		data[clefFieldLevel] = lvl
+	}
+
+	return marshalJSONEntry(data)
+}
+
+// clefLevel maps a LogLevel to a Serilog level.
+func clefLevel(lvl LogLevel) string {
+	switch lvl {
+	case TraceLevel:
+		return "Verbose"
+	case DebugLevel:
+		return "Debug"
+	case WarnLevel:
+		return "Warning"
+	case ErrorLevel:
+		return "Error"
+	case FatalLevel, PanicLevel:
+		return "Fatal"
+	default:
+		return "Information"
+	}
+}
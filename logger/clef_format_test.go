@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestCLEFFormat(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	testLogger := getTestLogger(&buf)
+	testLogger.core.clock = clocktesting.NewFakeClock(now)
+	testLogger.EnableJSONOutput(true)
+	testLogger.SetJSONFormat(JSONFormatCLEF)
+	testLogger.SetAppID("dapr-app")
+
+	t.Run("information", func(t *testing.T) {
+		buf.Reset()
+		testLogger.WithFields(map[string]any{"@id": 1}).Info("started")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "2026-03-01T12:30:00Z", o[clefFieldTimestamp])
+		assert.Equal(t, "started", o[clefFieldMessage])
+		assert.NotContains(t, o, clefFieldLevel)
+		assert.Equal(t, fakeLoggerName, o[logFieldScope])
+		assert.Equal(t, "dapr-app", o[logFieldAppID])
+		assert.InDelta(t, 1, o["@@id"], 0)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		buf.Reset()
+		testLogger.WithError(errors.New("boom")).Error("failed")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "Error", o[clefFieldLevel])
+		assert.Equal(t, "boom", o[clefFieldException])
+		assert.NotContains(t, o, logFieldError)
+	})
+}
+
+func TestCLEFLevel(t *testing.T) {
+	assert.Equal(t, "Verbose", clefLevel(TraceLevel))
+	assert.Equal(t, "Warning", clefLevel(WarnLevel))
+	assert.Equal(t, "Fatal", clefLevel(FatalLevel))
+	assert.Equal(t, "Information", clefLevel(InfoLevel))
+}
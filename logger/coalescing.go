@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	kclock "k8s.io/utils/clock"
+)
+
+const logFieldRepeatCount = "repeat_count"
+
+// EnableCoalescing holds each entry until a different one is logged or maxHold
+// elapses, and emits it once with the number of identical consecutive entries
+// in the repeat_count field. Fatal entries are never held.
+// A maxHold of zero or less emits the held entry and disables coalescing.
+func (l *daprLogger) EnableCoalescing(maxHold time.Duration) {
+	var c *coalescer
+	if maxHold > 0 {
+		c = &coalescer{
+			clock:   l.core.clock,
+			maxHold: maxHold,
+			write:   l.write,
+		}
+	}
+
+	if prev := l.core.coalescer.Swap(c); prev != nil {
+		prev.flush()
+	}
+}
+
+// coalescer collapses identical consecutive entries.
+type coalescer struct {
+	lock    sync.Mutex
+	clock   kclock.WithDelayedExecution
+	maxHold time.Duration
+	write   func(*logrus.Entry)
+
+	held  *logrus.Entry
+	count int
+	timer kclock.Timer
+}
+
+// add holds e, emitting the previously held entry if e differs from it.
+func (c *coalescer) add(e *logrus.Entry) {
+	c.lock.Lock()
+	if c.held != nil && sameEntry(c.held, e) {
+		c.count++
+		c.lock.Unlock()
+		return
+	}
+
+	prev, count := c.takeLocked()
+	c.held = e
+	c.count = 1
+	c.timer = c.clock.AfterFunc(c.maxHold, func() {
+		c.flushEntry(e)
+	})
+	c.lock.Unlock()
+
+	c.emit(prev, count)
+}
+
+// flush emits the held entry, if any.
+func (c *coalescer) flush() {
+	c.lock.Lock()
+	prev, count := c.takeLocked()
+	c.lock.Unlock()
+
+	c.emit(prev, count)
+}
+
+// flushEntry emits the held entry if it's still e.
+func (c *coalescer) flushEntry(e *logrus.Entry) {
+	c.lock.Lock()
+	if c.held != e {
+		c.lock.Unlock()
+		return
+	}
+	// The timer already fired.
+	c.timer = nil
+	prev, count := c.takeLocked()
+	c.lock.Unlock()
+
+	c.emit(prev, count)
+}
+
+func (c *coalescer) takeLocked() (*logrus.Entry, int) {
+	held, count := c.held, c.count
+	if c.timer != nil {
+		c.timer.Stop()
+		c.timer = nil
+	}
+	c.held = nil
+	c.count = 0
+
+	return held, count
+}
+
+func (c *coalescer) emit(e *logrus.Entry, count int) {
+	if e == nil {
+		return
+	}
+
+	if count > 1 {
+		e.Data[logFieldRepeatCount] = count
+	}
+	c.write(e)
+}
+
+// sameEntry returns true if a and b only differ by their time.
+func sameEntry(a, b *logrus.Entry) bool {
+	return a.Level == b.Level &&
+		a.Message == b.Message &&
+		reflect.DeepEqual(a.Data, b.Data)
+}
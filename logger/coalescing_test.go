@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestCoalescing(t *testing.T) {
+	t.Run("identical consecutive entries", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+		testLogger.EnableCoalescing(time.Hour)
+
+		for range 5 {
+			testLogger.Warn("connection refused")
+		}
+		assert.Empty(t, buf.Bytes())
+
+		testLogger.Info("connected")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "connection refused", o[logFieldMessage])
+		assert.InDelta(t, float64(5), o[logFieldRepeatCount], 0)
+		assert.Empty(t, buf.Bytes())
+
+		// Disabling coalescing emits the held entry.
+		testLogger.EnableCoalescing(0)
+
+		o = readTestEntry(t, &buf)
+		assert.Equal(t, "connected", o[logFieldMessage])
+		assert.NotContains(t, o, logFieldRepeatCount)
+	})
+
+	t.Run("different fields are not coalesced", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+		testLogger.EnableCoalescing(time.Hour)
+
+		testLogger.WithFields(map[string]any{"attempt": 1}).Info("retrying")
+		testLogger.WithFields(map[string]any{"attempt": 2}).Info("retrying")
+		testLogger.EnableCoalescing(0)
+
+		o := readTestEntry(t, &buf)
+		assert.InDelta(t, float64(1), o["attempt"], 0)
+		assert.NotContains(t, o, logFieldRepeatCount)
+
+		o = readTestEntry(t, &buf)
+		assert.InDelta(t, float64(2), o["attempt"], 0)
+	})
+
+	t.Run("flushed after max hold", func(t *testing.T) {
+		var buf syncBuffer
+
+		clock := clocktesting.NewFakeClock(time.Now())
+		testLogger := getTestLogger(&buf)
+		testLogger.core.clock = clock
+		testLogger.EnableJSONOutput(true)
+		testLogger.EnableCoalescing(time.Second)
+
+		testLogger.Error("failed")
+		testLogger.Error("failed")
+		assert.Empty(t, buf.String())
+
+		clock.Step(time.Second)
+
+		var o map[string]any
+		require.NoError(t, json.Unmarshal([]byte(buf.String()), &o))
+		assert.Equal(t, "failed", o[logFieldMessage])
+		assert.InDelta(t, float64(2), o[logFieldRepeatCount], 0)
+	})
+
+	t.Run("fatal entries are not held", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+		testLogger.EnableCoalescing(time.Hour)
+
+		testLogger.Error("failed")
+		testLogger.Fatal("giving up")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "failed", o[logFieldMessage])
+		o = readTestEntry(t, &buf)
+		assert.Equal(t, "giving up", o[logFieldMessage])
+	})
+}
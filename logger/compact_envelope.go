@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+const logFieldCompactEnvelope = "m"
+
+// compactEnvelopeFields are the envelope fields moved to the m field, in order.
+var compactEnvelopeFields = [...]string{
+	logFieldScope,
+	logFieldType,
+	logFieldInstance,
+	logFieldAppID,
+}
+
+// SetCompactEnvelope enables moving the envelope fields of JSON entries into
+// a single m field, to save bytes on high-volume logs.
+//
+// The m field is an array holding, in order, the scope, type, instance and
+// app_id fields, with null for the fields not set. For example:
+//
+//	{"m":["dapr.runtime","log","host-1",null],"msg":"hello",...}
+//
+// ExpandCompactEnvelope restores the original fields.
+func (l *daprLogger) SetCompactEnvelope(enabled bool) {
+	l.core.compactEnvelope.Store(enabled)
+}
+
+// compactEnvelope moves the envelope fields of data into the m field.
+func compactEnvelope(data logrus.Fields) {
+	m := make([]any, len(compactEnvelopeFields))
+	for i, k := range compactEnvelopeFields {
+		if v, ok := data[k]; ok {
+			m[i] = v
+			delete(data, k)
+		}
+	}
+	data[logFieldCompactEnvelope] = m
+}
+
+// ExpandCompactEnvelope returns the fields of an entry decoded from JSON with
+// the m field, if any, expanded back to the envelope fields. fields is
+// modified in place.
+func ExpandCompactEnvelope(fields map[string]any) map[string]any {
+	m, ok := fields[logFieldCompactEnvelope].([]any)
+	if !ok {
+		return fields
+	}
+
+	delete(fields, logFieldCompactEnvelope)
+	for i, k := range compactEnvelopeFields {
+		if i < len(m) && m[i] != nil {
+			fields[k] = m[i]
+		}
+	}
+
+	return fields
+}
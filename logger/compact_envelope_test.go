@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetCompactEnvelope(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.SetAppID("my-app")
+
+	testLogger.Info("expanded")
+
+	expanded := readTestEntry(t, &buf)
+
+	testLogger.SetCompactEnvelope(true)
+
+	t.Run("compact form", func(t *testing.T) {
+		testLogger.Info("compacted")
+
+		o := readTestEntry(t, &buf)
+		buf.Reset()
+
+		assert.Equal(t, []any{fakeLoggerName, LogTypeLog, expanded[logFieldInstance], "my-app"}, o[logFieldCompactEnvelope])
+		for _, k := range compactEnvelopeFields {
+			assert.NotContains(t, o, k)
+		}
+		assert.Equal(t, "compacted", o[logFieldMessage])
+
+		o = ExpandCompactEnvelope(o)
+		assert.NotContains(t, o, logFieldCompactEnvelope)
+		for _, k := range compactEnvelopeFields {
+			assert.Equal(t, expanded[k], o[k], k)
+		}
+	})
+
+	t.Run("fields not set", func(t *testing.T) {
+		otherLogger := newDaprLogger("other")
+		otherLogger.SetOutput(&buf)
+		otherLogger.EnableJSONOutput(true)
+		otherLogger.SetCompactEnvelope(true)
+		otherLogger.Info("without app id")
+
+		o := readTestEntry(t, &buf)
+		buf.Reset()
+
+		o = ExpandCompactEnvelope(o)
+		assert.Equal(t, "other", o[logFieldScope])
+		assert.NotContains(t, o, logFieldAppID)
+	})
+
+	t.Run("parsed entry", func(t *testing.T) {
+		testLogger.Info("parsed")
+
+		e, err := ParseEntry(buf.Bytes())
+		require.NoError(t, err)
+		buf.Reset()
+
+		assert.Equal(t, fakeLoggerName, e.Scope)
+		assert.Equal(t, LogTypeLog, e.Type)
+		assert.Equal(t, "my-app", e.Fields[logFieldAppID])
+	})
+
+	t.Run("text output", func(t *testing.T) {
+		testLogger.EnableJSONOutput(false)
+		testLogger.Info("text")
+
+		assert.Contains(t, buf.String(), "scope="+fakeLoggerName)
+		assert.NotContains(t, buf.String(), " m=")
+	})
+}
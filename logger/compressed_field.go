@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+)
+
+const (
+	// CompressedFieldEncoding is the value of the <key>_encoding marker of the
+	// fields added with WithCompressedField.
+	CompressedFieldEncoding = "gzip+base64"
+
+	compressedFieldEncodingSuffix = "_encoding"
+)
+
+// WithCompressedField returns a logger with data gzipped and base64-encoded
+// in the key field, plus a <key>_encoding=gzip+base64 field so consumers know
+// how to decode it with DecodeCompressedField.
+func (l *daprLogger) WithCompressedField(key string, data []byte) Logger {
+	var buf bytes.Buffer
+	enc := base64.NewEncoder(base64.StdEncoding, &buf)
+	zw := gzip.NewWriter(enc)
+
+	// Writes to a bytes.Buffer never fail.
+	_, _ = zw.Write(data)
+	_ = zw.Close()
+	_ = enc.Close()
+
+	return l.WithFields(map[string]any{
+		key:                                 buf.String(),
+		key + compressedFieldEncodingSuffix: CompressedFieldEncoding,
+	})
+}
+
+// DecodeCompressedField decodes the value of a field added with
+// WithCompressedField.
+func DecodeCompressedField(value string) ([]byte, error) {
+	zr, err := gzip.NewReader(base64.NewDecoder(base64.StdEncoding, bytes.NewBufferString(value)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode compressed field: %w", err)
+	}
+	defer zr.Close()
+
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode compressed field: %w", err)
+	}
+
+	return data, nil
+}
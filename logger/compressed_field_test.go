@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithCompressedField(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	data := []byte(strings.Repeat(`{"key":"value"},`, 1000))
+	testLogger.WithCompressedField("payload", data).Info("large diagnostic")
+
+	o := readTestEntry(t, &buf)
+
+	assert.Equal(t, CompressedFieldEncoding, o["payload_encoding"])
+
+	encoded, ok := o["payload"].(string)
+	require.True(t, ok)
+	assert.Less(t, len(encoded), len(data))
+
+	decoded, err := DecodeCompressedField(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, data, decoded)
+}
+
+func TestDecodeCompressedFieldInvalid(t *testing.T) {
+	_, err := DecodeCompressedField("not compressed")
+	require.Error(t, err)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"reflect"
+	"slices"
+)
+
+const logFieldConfigChanges = "config_changes"
+
+// ConfigChanges is the set of changes between two configurations.
+type ConfigChanges struct {
+	// Added holds the keys only present in the new configuration.
+	Added map[string]any `json:"added,omitempty"`
+	// Removed lists the keys only present in the old configuration.
+	Removed []string `json:"removed,omitempty"`
+	// Changed holds the keys whose value differs between both configurations.
+	Changed map[string]ConfigChange `json:"changed,omitempty"`
+}
+
+// ConfigChange is the before and after value of a changed config key.
+type ConfigChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// redactConfigValue returns value with the redactors applied, as if it were
+// the value of the field key, including to the nested maps and slices.
+func redactConfigValue(key string, value any) any {
+	rs := redactors.Load()
+	if rs == nil || len(*rs) == 0 {
+		return value
+	}
+
+	return redactValue(*rs, key, value)
+}
+
+// diffConfig computes the changes from oldConfig to newConfig, redacting the
+// values like the fields of the entries, see RegisterRedactor.
+func diffConfig(oldConfig, newConfig map[string]any) ConfigChanges {
+	var changes ConfigChanges
+
+	for k, after := range newConfig {
+		before, ok := oldConfig[k]
+		switch {
+		case !ok:
+			if changes.Added == nil {
+				changes.Added = map[string]any{}
+			}
+			changes.Added[k] = redactConfigValue(k, after)
+		case !reflect.DeepEqual(before, after):
+			if changes.Changed == nil {
+				changes.Changed = map[string]ConfigChange{}
+			}
+			changes.Changed[k] = ConfigChange{
+				Before: redactConfigValue(k, before),
+				After:  redactConfigValue(k, after),
+			}
+		}
+	}
+
+	for k := range oldConfig {
+		if _, ok := newConfig[k]; !ok {
+			changes.Removed = append(changes.Removed, k)
+		}
+	}
+	slices.Sort(changes.Removed)
+
+	return changes
+}
+
+// LogConfigReload logs the keys added, removed and changed between the old and
+// new configuration under the config_changes field. The values are redacted
+// by the redactors of the entries, so the keys of DefaultRedactedKeys and the
+// ones registered with RegisterRedactor are not logged in clear.
+func (l *daprLogger) LogConfigReload(oldConfig, newConfig map[string]any) {
+	if !l.IsOutputLevelEnabled(InfoLevel) {
+		return
+	}
+
+	l.WithFields(map[string]any{
+		logFieldConfigChanges: diffConfig(oldConfig, newConfig),
+	}).Info("Configuration reloaded")
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogConfigReload(t *testing.T) {
+	var buf bytes.Buffer
+
+	RegisterRedactor(RedactKeys("licenseKey"))
+	t.Cleanup(func() { SetRedactors(DefaultRedactors()...) })
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	testLogger.LogConfigReload(
+		map[string]any{
+			"logLevel":   "info",
+			"maxRetries": 3,
+			"licenseKey": "old-key",
+			"legacy":     true,
+			"redis":      map[string]any{"host": "redis-0", "password": "old-pass"},
+		},
+		map[string]any{
+			"logLevel":      "debug",
+			"maxRetries":    3,
+			"licenseKey":    "new-key",
+			"dbPassword":    "hunter2",
+			"enableTracing": true,
+			"redis":         map[string]any{"host": "redis-1", "password": "new-pass"},
+			"brokers":       []any{map[string]any{"url": "kafka-0", "token": "t0"}},
+		},
+	)
+
+	b, err := buf.ReadBytes('\n')
+	require.NoError(t, err)
+
+	var o struct {
+		Msg     string        `json:"msg"`
+		Changes ConfigChanges `json:"config_changes"`
+	}
+	require.NoError(t, json.Unmarshal(b, &o))
+
+	assert.Equal(t, "Configuration reloaded", o.Msg)
+	assert.Equal(t, map[string]any{
+		"dbPassword":    RedactedValue,
+		"enableTracing": true,
+		"brokers":       []any{map[string]any{"url": "kafka-0", "token": RedactedValue}},
+	}, o.Changes.Added)
+	assert.Equal(t, []string{"legacy"}, o.Changes.Removed)
+	assert.Equal(t, map[string]ConfigChange{
+		"logLevel":   {Before: "info", After: "debug"},
+		"licenseKey": {Before: RedactedValue, After: RedactedValue},
+		"redis": {
+			Before: map[string]any{"host": "redis-0", "password": RedactedValue},
+			After:  map[string]any{"host": "redis-1", "password": RedactedValue},
+		},
+	}, o.Changes.Changed)
+}
+
+func TestDiffConfigNoChanges(t *testing.T) {
+	changes := diffConfig(map[string]any{"a": 1}, map[string]any{"a": 1})
+	assert.Empty(t, changes.Added)
+	assert.Empty(t, changes.Removed)
+	assert.Empty(t, changes.Changed)
+}
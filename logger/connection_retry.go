@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	logFieldConnectionTarget = "connection_target"
+	logFieldTotalAttempts    = "total_attempts"
+	logFieldTotalFailures    = "total_failures"
+	logFieldLastSuccess      = "last_success"
+)
+
+// connectionStats aggregates the connection attempts of each target.
+type connectionStats struct {
+	lock    sync.Mutex
+	targets map[string]*connectionTargetStats
+}
+
+type connectionTargetStats struct {
+	attempts    int64
+	failures    int64
+	lastSuccess time.Time
+}
+
+// connectionAttempt is a connection attempt whose outcome is not recorded yet.
+type connectionAttempt struct {
+	once   sync.Once
+	stats  *connectionStats
+	target string
+}
+
+// attempt records a new attempt to connect to target.
+func (s *connectionStats) attempt(target string) *connectionAttempt {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.targets == nil {
+		s.targets = make(map[string]*connectionTargetStats)
+	}
+	ts, ok := s.targets[target]
+	if !ok {
+		ts = &connectionTargetStats{}
+		s.targets[target] = ts
+	}
+	ts.attempts++
+
+	return &connectionAttempt{
+		stats:  s,
+		target: target,
+	}
+}
+
+// get returns a copy of the stats of target.
+func (s *connectionStats) get(target string) connectionTargetStats {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if ts, ok := s.targets[target]; ok {
+		return *ts
+	}
+
+	return connectionTargetStats{}
+}
+
+// record records the outcome of the attempt from the level of its first
+// entry: Warn and more severe levels are failures, the others successes.
+func (a *connectionAttempt) record(level logrus.Level, now time.Time) {
+	a.once.Do(func() {
+		a.stats.lock.Lock()
+		defer a.stats.lock.Unlock()
+
+		ts := a.stats.targets[a.target]
+		if level <= logrus.WarnLevel {
+			ts.failures++
+		} else {
+			ts.lastSuccess = now
+		}
+	})
+}
+
+// WithConnectionRetry records an attempt to connect to target and returns a
+// logger for it, with the connection_target and attempt fields. The first
+// entry logged with it records the outcome of the attempt: a failure when
+// logged at level Warn or Error, a success otherwise.
+func (l *daprLogger) WithConnectionRetry(target string, attempt int) Logger {
+	dl := l.derive(l.logger.WithFields(logrus.Fields{
+		logFieldConnectionTarget: target,
+		logFieldAttempt:          attempt,
+	}))
+	dl.retry = l.core.connections.attempt(target)
+
+	return dl
+}
+
+// LogConnectionStats logs at level Info the number of attempts made to connect
+// to target with WithConnectionRetry, how many failed and the time of the last
+// successful one, which is null if none succeeded.
+func (l *daprLogger) LogConnectionStats(target string) {
+	ts := l.core.connections.get(target)
+
+	var lastSuccess any
+	if !ts.lastSuccess.IsZero() {
+		lastSuccess = ts.lastSuccess.Format(time.RFC3339Nano)
+	}
+
+	l.WithFields(map[string]any{
+		logFieldConnectionTarget: target,
+		logFieldTotalAttempts:    ts.attempts,
+		logFieldTotalFailures:    ts.failures,
+		logFieldLastSuccess:      lastSuccess,
+	}).Infof("Connection stats for %s: %d attempts, %d failures", target, ts.attempts, ts.failures)
+}
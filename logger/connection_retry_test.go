@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestConnectionRetry(t *testing.T) {
+	var buf bytes.Buffer
+
+	clock := clocktesting.NewFakeClock(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.core.clock = clock
+
+	t.Run("retry fields", func(t *testing.T) {
+		testLogger.WithConnectionRetry("redis:6379", 1).Warn("Connection refused")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "redis:6379", o[logFieldConnectionTarget])
+		assert.InDelta(t, float64(1), o[logFieldAttempt], 0)
+	})
+
+	t.Run("aggregate stats", func(t *testing.T) {
+		testLogger.WithConnectionRetry("redis:6379", 2).Error("Connection timed out")
+
+		clock.Step(time.Second)
+		retry := testLogger.WithConnectionRetry("redis:6379", 3)
+		retry.Info("Connected")
+		// Only the first entry of an attempt records its outcome.
+		retry.Warn("Slow handshake")
+
+		testLogger.WithConnectionRetry("postgres:5432", 1).Error("Connection refused")
+		buf.Reset()
+
+		testLogger.LogConnectionStats("redis:6379")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "info", o[logFieldLevel])
+		assert.Equal(t, "redis:6379", o[logFieldConnectionTarget])
+		assert.InDelta(t, float64(3), o[logFieldTotalAttempts], 0)
+		assert.InDelta(t, float64(2), o[logFieldTotalFailures], 0)
+		assert.Equal(t, "2026-03-01T12:00:01Z", o[logFieldLastSuccess])
+	})
+
+	t.Run("no success", func(t *testing.T) {
+		testLogger.LogConnectionStats("postgres:5432")
+
+		o := readTestEntry(t, &buf)
+		assert.InDelta(t, float64(1), o[logFieldTotalAttempts], 0)
+		assert.InDelta(t, float64(1), o[logFieldTotalFailures], 0)
+		assert.Contains(t, o, logFieldLastSuccess)
+		assert.Nil(t, o[logFieldLastSuccess])
+	})
+
+	t.Run("unknown target", func(t *testing.T) {
+		testLogger.LogConnectionStats("kafka:9092")
+
+		o := readTestEntry(t, &buf)
+		assert.InDelta(t, float64(0), o[logFieldTotalAttempts], 0)
+	})
+}
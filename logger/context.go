@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"context"
+	"maps"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	logFieldTraceID      = "trace_id"
+	logFieldSpanID       = "span_id"
+	logFieldTraceFlags   = "trace_flags"
+	logFieldTraceSampled = "trace_sampled"
+
+	logFieldLinkTraceID      = "link_trace_id"
+	logFieldLinkSpanID       = "link_span_id"
+	logFieldLinkTraceFlags   = "link_trace_flags"
+	logFieldLinkTraceSampled = "link_trace_sampled"
+)
+
+type fieldsContextKeyType struct{}
+
+// fieldsContextKey is how we find the request-scoped fields in a context.Context
+var fieldsContextKey = fieldsContextKeyType{}
+
+// ContextWithFields returns a new Context, derived from ctx, which carries the
+// provided fields in addition to the ones already in ctx, for the loggers
+// returned by WithContext and FromContext.
+func ContextWithFields(ctx context.Context, fields map[string]any) context.Context {
+	merged := maps.Clone(fieldsFromContext(ctx))
+	if merged == nil {
+		merged = make(map[string]any, len(fields))
+	}
+	maps.Copy(merged, fields)
+
+	return context.WithValue(ctx, fieldsContextKey, merged)
+}
+
+func fieldsFromContext(ctx context.Context) map[string]any {
+	fields, _ := ctx.Value(fieldsContextKey).(map[string]any)
+	return fields
+}
+
+// FromContext returns the Logger carried by ctx, with the fields found in ctx
+// as added by WithContext. It returns false if ctx doesn't carry a Logger.
+func FromContext(ctx context.Context) (Logger, bool) {
+	l, ok := ctx.Value(logContextKey).(Logger)
+	if !ok {
+		return nil, false
+	}
+
+	return l.WithContext(ctx), true
+}
+
+// WithContext returns a logger carrying the fields found in ctx: the fields
+// added with ContextWithFields and, when ctx carries a span, its IDs as hex in
+// trace_id and span_id, its W3C trace flags as hex in trace_flags and the
+// sampled bit in trace_sampled.
+func (l *daprLogger) WithContext(ctx context.Context) Logger {
+	entry := l.logger.WithContext(ctx)
+
+	if fields := fieldsFromContext(ctx); len(fields) > 0 {
+		entry = entry.WithFields(fields)
+	}
+
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		entry = entry.WithFields(map[string]any{
+			logFieldTraceID:      sc.TraceID().String(),
+			logFieldSpanID:       sc.SpanID().String(),
+			logFieldTraceFlags:   sc.TraceFlags().String(),
+			logFieldTraceSampled: sc.IsSampled(),
+		})
+	}
+
+	return l.derive(entry)
+}
+
+// WithSpanLink returns a logger carrying the span link, such as the span of
+// the message that started the current one: its IDs as hex in link_trace_id
+// and link_span_id, its W3C trace flags as hex in link_trace_flags and the
+// sampled bit in link_trace_sampled. The logger is returned unchanged if the
+// link isn't valid.
+func (l *daprLogger) WithSpanLink(link trace.SpanContext) Logger {
+	if !link.IsValid() {
+		return l
+	}
+
+	return l.derive(l.logger.WithFields(map[string]any{
+		logFieldLinkTraceID:      link.TraceID().String(),
+		logFieldLinkSpanID:       link.SpanID().String(),
+		logFieldLinkTraceFlags:   link.TraceFlags().String(),
+		logFieldLinkTraceSampled: link.IsSampled(),
+	}))
+}
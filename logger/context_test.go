@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func testSpanContext(flags trace.TraceFlags) trace.SpanContext {
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+		SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+		TraceFlags: flags,
+	})
+}
+
+func TestWithContextTraceFlags(t *testing.T) {
+	logWithContext := func(t *testing.T, l *daprLogger, buf *bytes.Buffer, sc trace.SpanContext) map[string]any {
+		t.Helper()
+
+		ctx := t.Context()
+		if sc.IsValid() {
+			ctx = trace.ContextWithSpanContext(ctx, sc)
+		}
+		l.WithContext(ctx).Info("traced")
+
+		return readTestEntry(t, buf)
+	}
+
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	t.Run("sampled", func(t *testing.T) {
+		o := logWithContext(t, testLogger, &buf, testSpanContext(trace.FlagsSampled))
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", o[logFieldTraceID])
+		assert.Equal(t, "00f067aa0ba902b7", o[logFieldSpanID])
+		assert.Equal(t, "01", o[logFieldTraceFlags])
+		assert.Equal(t, true, o[logFieldTraceSampled])
+	})
+
+	t.Run("not sampled", func(t *testing.T) {
+		o := logWithContext(t, testLogger, &buf, testSpanContext(0))
+		assert.Equal(t, "00", o[logFieldTraceFlags])
+		assert.Equal(t, false, o[logFieldTraceSampled])
+	})
+
+	t.Run("no span", func(t *testing.T) {
+		o := logWithContext(t, testLogger, &buf, trace.SpanContext{})
+		assert.NotContains(t, o, logFieldTraceID)
+		assert.NotContains(t, o, logFieldSpanID)
+		assert.NotContains(t, o, logFieldTraceFlags)
+		assert.NotContains(t, o, logFieldTraceSampled)
+	})
+}
+
+func TestWithSpanLink(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	t.Run("sampled link", func(t *testing.T) {
+		testLogger.WithSpanLink(testSpanContext(trace.FlagsSampled)).Info("linked")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", o[logFieldLinkTraceID])
+		assert.Equal(t, "00f067aa0ba902b7", o[logFieldLinkSpanID])
+		assert.Equal(t, "01", o[logFieldLinkTraceFlags])
+		assert.Equal(t, true, o[logFieldLinkTraceSampled])
+		assert.NotContains(t, o, logFieldTraceID)
+	})
+
+	t.Run("not sampled link", func(t *testing.T) {
+		testLogger.WithSpanLink(testSpanContext(0)).Info("linked")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "00", o[logFieldLinkTraceFlags])
+		assert.Equal(t, false, o[logFieldLinkTraceSampled])
+	})
+
+	t.Run("invalid link", func(t *testing.T) {
+		testLogger.WithSpanLink(trace.SpanContext{}).Info("not linked")
+
+		o := readTestEntry(t, &buf)
+		assert.NotContains(t, o, logFieldLinkTraceID)
+		assert.NotContains(t, o, logFieldLinkTraceFlags)
+	})
+}
+
+func TestContextFields(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	ctx := ContextWithFields(t.Context(), map[string]any{"request_id": "req-1", "tenant": "acme"})
+	ctx = ContextWithFields(ctx, map[string]any{"tenant": "globex", "route": "/orders"})
+
+	t.Run("WithContext", func(t *testing.T) {
+		testLogger.WithContext(ctx).Info("handled")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "req-1", o["request_id"])
+		assert.Equal(t, "globex", o["tenant"])
+		assert.Equal(t, "/orders", o["route"])
+	})
+
+	t.Run("parent context is unchanged", func(t *testing.T) {
+		parent := ContextWithFields(t.Context(), map[string]any{"tenant": "acme"})
+		_ = ContextWithFields(parent, map[string]any{"tenant": "globex"})
+
+		testLogger.WithContext(parent).Info("handled")
+
+		assert.Equal(t, "acme", readTestEntry(t, &buf)["tenant"])
+	})
+
+	t.Run("FromContext", func(t *testing.T) {
+		l, ok := FromContext(NewContext(ctx, testLogger))
+		require.True(t, ok)
+
+		l.Info("from context")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "from context", o[logFieldMessage])
+		assert.Equal(t, "req-1", o["request_id"])
+	})
+
+	t.Run("FromContext without logger", func(t *testing.T) {
+		l, ok := FromContext(ctx)
+		assert.False(t, ok)
+		assert.Nil(t, l)
+	})
+}
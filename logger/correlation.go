@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+)
+
+const logFieldCorrelationID = "correlation_id"
+
+// FromHTTPHeaders returns base with a correlation_id field set from the first
+// of headerNames present in r. If none is present, a random correlation ID is
+// generated.
+func FromHTTPHeaders(r *http.Request, headerNames []string, base Logger) Logger {
+	var id string
+	for _, name := range headerNames {
+		if id = r.Header.Get(name); id != "" {
+			break
+		}
+	}
+
+	if id == "" {
+		id = newCorrelationID()
+	}
+
+	return base.WithFields(map[string]any{
+		logFieldCorrelationID: id,
+	})
+}
+
+// newCorrelationID returns a random 128-bit ID, hex-encoded.
+func newCorrelationID() string {
+	b := make([]byte, 16)
+	// crypto/rand.Read never returns an error.
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromHTTPHeaders(t *testing.T) {
+	headerNames := []string{"X-Correlation-ID", "X-Request-ID"}
+
+	correlationID := func(t *testing.T, l Logger, buf *bytes.Buffer) string {
+		t.Helper()
+
+		l.Info("request")
+
+		o := readTestEntry(t, buf)
+
+		id, _ := o[logFieldCorrelationID].(string)
+		return id
+	}
+
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	t.Run("first matching header", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/", nil)
+		r.Header.Set("X-Request-ID", "req-id")
+		r.Header.Set("X-Correlation-ID", "corr-id")
+
+		assert.Equal(t, "corr-id", correlationID(t, FromHTTPHeaders(r, headerNames, testLogger), &buf))
+	})
+
+	t.Run("fallback header", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/", nil)
+		r.Header.Set("X-Request-ID", "req-id")
+
+		assert.Equal(t, "req-id", correlationID(t, FromHTTPHeaders(r, headerNames, testLogger), &buf))
+	})
+
+	t.Run("generated when absent", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/", nil)
+
+		id1 := correlationID(t, FromHTTPHeaders(r, headerNames, testLogger), &buf)
+		id2 := correlationID(t, FromHTTPHeaders(r, headerNames, testLogger), &buf)
+		assert.Len(t, id1, 32)
+		assert.NotEqual(t, id1, id2)
+	})
+
+	t.Run("base logger is unchanged", func(t *testing.T) {
+		assert.Empty(t, correlationID(t, testLogger, &buf))
+	})
+}
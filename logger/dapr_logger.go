@@ -14,11 +14,17 @@ limitations under the License.
 package logger
 
 import (
+	"fmt"
 	"io"
+	"maps"
+	"math"
 	"os"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/sirupsen/logrus"
+	kclock "k8s.io/utils/clock"
 )
 
 // daprLogger is the implemention for logrus.
@@ -27,6 +33,93 @@ type daprLogger struct {
 	name string
 	// loger is the instance of logrus logger
 	logger *logrus.Entry
+	// core is the state shared with every logger derived from this one
+	core *loggerCore
+	// channel is the channel the entries are written to, if any
+	channel string
+	// retry is the connection attempt whose outcome is recorded by the next entry, if any
+	retry *connectionAttempt
+	// minSeverity is the least severe level entries are logged at, entries
+	// logged at a less severe level are raised to it; zero if not set
+	minSeverity logrus.Level
+}
+
+// loggerCore holds the state shared by a named logger and all the loggers
+// derived from it with WithLogType or WithFields.
+type loggerCore struct {
+	// lock serializes formatting and writing entries
+	lock  sync.Mutex
+	clock kclock.WithDelayedExecution
+
+	// enabledLevels is a bitmask of the levels enabled with SetEnabledLevels,
+	// or zero to use the output level
+	enabledLevels atomic.Uint32
+	fieldKeyCase  atomic.Int32
+	frameHeader   atomic.Int32
+	// floatPrecision is the number of decimal places of float fields, or -1
+	floatPrecision atomic.Int32
+	schemaVersion  atomic.Pointer[string]
+	degradation    atomic.Pointer[degradationTracker]
+	coalescer      atomic.Pointer[coalescer]
+	sampler        atomic.Pointer[sampler]
+	messageSampler atomic.Pointer[messageSampler]
+	channels       atomic.Pointer[map[string]channel]
+	partitioning   atomic.Pointer[partitioning]
+	cardinality    atomic.Pointer[map[string]*hyperLogLog]
+	// queueHighWaterMark holds the float64 bits of the queue high-water mark
+	queueHighWaterMark atomic.Uint64
+	// compactEnvelope moves the envelope fields of JSON entries into the m field
+	compactEnvelope atomic.Bool
+	// caller adds the caller and func fields
+	caller atomic.Bool
+	// stackTrace adds the stacktrace field to the loggers returned by WithError
+	stackTrace atomic.Bool
+	// structuredErrors adds the error.causes field, see EnableStructuredErrors
+	structuredErrors atomic.Bool
+	// utc writes the time field in UTC, see SetTimestampFormat
+	utc atomic.Bool
+	// forceColors colorizes the text output, see ForceColors
+	forceColors atomic.Bool
+	// maxMessageBytes and maxFieldBytes are the size limits, see SetSizeLimits
+	maxMessageBytes atomic.Int64
+	maxFieldBytes   atomic.Int64
+	// fieldFilter drops the fields of the entries, see SetFieldFilter
+	fieldFilter atomic.Pointer[fieldFilter]
+	// repeatSuppressor drops identical consecutive entries, see SuppressRepeats
+	repeatSuppressor atomic.Pointer[repeatSuppressor]
+	onWrite          []func(Entry, int, error)
+	readiness        readinessStates
+	connections      connectionStats
+	// exporter exports the entries written, see Options.OTLPEndpoint
+	exporter atomic.Pointer[OTLPExporter]
+
+	// auditedSink receives a copy of the Restricted entries, guarded by lock
+	auditedSink io.Writer
+	// auditWriter receives the audit entries, guarded by lock
+	auditWriter io.Writer
+	// sinks receive the entries in addition to the output, guarded by lock
+	sinks []sink
+	// baseFields are the fields set with the WithFields and WithAppID
+	// options and SetAppID, kept by EnableJSONOutput; SetAppID replaces the map
+	baseFields logrus.Fields
+	// tenantRouting returns the destination of the entries of a tenant, guarded by lock
+	tenantRouting func(tenantID string) io.Writer
+	// retention keeps the most recent entries written, guarded by lock
+	retention *entryRetention
+	// samplerSeed is the seed of the sampler, or nil for a random one, guarded by lock
+	samplerSeed *int64
+	// levelOutputs are the destinations of the entries per level, guarded by lock
+	levelOutputs map[logrus.Level]io.Writer
+	// writeFunc writes the entries in place of the formatter and output, guarded by lock
+	writeFunc func(Entry) error
+	// timestampFormat is the layout of the time field, guarded by lock
+	timestampFormat string
+	// jsonFormat is the layout of the JSON output, guarded by lock
+	jsonFormat JSONFormat
+	// terminal is true if the output is a terminal, guarded by lock
+	terminal bool
+	// outputEncoding is the encoding of the output, guarded by lock
+	outputEncoding OutputEncoding
 }
 
 var DaprVersion = "unknown"
@@ -41,51 +134,109 @@ func newDaprLogger(name string) *daprLogger {
 			logFieldScope: name,
 			logFieldType:  LogTypeLog,
 		}),
+		core: &loggerCore{
+			clock:           kclock.RealClock{},
+			timestampFormat: DefaultTimestampFormat,
+			terminal:        isTerminal(os.Stdout),
+		},
 	}
 
+	schemaVersion := DefaultSchemaVersion
+	dl.core.schemaVersion.Store(&schemaVersion)
+	dl.core.floatPrecision.Store(-1)
+	dl.core.queueHighWaterMark.Store(math.Float64bits(DefaultQueueHighWaterMark))
+
 	dl.EnableJSONOutput(defaultJSONOutput)
 
 	return dl
 }
 
+// formatterFieldMap renames the fields logrus adds to the entries.
+var formatterFieldMap = logrus.FieldMap{
+	// If time field name is conflicted, logrus adds "fields." prefix.
+	// So rename to unused field @time to avoid the confliction.
+	logrus.FieldKeyTime:  logFieldTimeStamp,
+	logrus.FieldKeyLevel: logFieldLevel,
+	logrus.FieldKeyMsg:   logFieldMessage,
+}
+
 // EnableJSONOutput enables JSON formatted output log.
 func (l *daprLogger) EnableJSONOutput(enabled bool) {
-	var formatter logrus.Formatter
-
-	fieldMap := logrus.FieldMap{
-		// If time field name is conflicted, logrus adds "fields." prefix.
-		// So rename to unused field @time to avoid the confliction.
-		logrus.FieldKeyTime:  logFieldTimeStamp,
-		logrus.FieldKeyLevel: logFieldLevel,
-		logrus.FieldKeyMsg:   logFieldMessage,
-	}
-
 	hostname, _ := os.Hostname()
-	l.logger.Data = logrus.Fields{
+	data := logrus.Fields{
 		logFieldScope:    l.logger.Data[logFieldScope],
 		logFieldType:     LogTypeLog,
 		logFieldInstance: hostname,
 		logFieldDaprVer:  DaprVersion,
 	}
+	if v, ok := l.logger.Data[logFieldComponentVer]; ok {
+		data[logFieldComponentVer] = v
+	}
+	maps.Copy(data, l.core.baseFields)
+	l.logger.Data = data
 
-	if enabled {
-		formatter = &logrus.JSONFormatter{ //nolint: exhaustruct
-			TimestampFormat: time.RFC3339Nano,
-			FieldMap:        fieldMap,
+	l.core.lock.Lock()
+	l.logger.Logger.SetFormatter(l.newFormatterLocked(enabled))
+	l.core.lock.Unlock()
+}
+
+// newFormatterLocked returns the formatter of the JSON or text output, with the
+// timestamp format, JSON format and output encoding of the logger. The caller
+// must hold core.lock.
+func (l *daprLogger) newFormatterLocked(jsonEnabled bool) logrus.Formatter {
+	return newFormatter(l.core.outputEncoding, jsonEnabled, l.core.jsonFormat, l.core.timestampFormat, l.colorsLocked())
+}
+
+// newFormatter returns the formatter of the JSON output with the given format,
+// or of the text output, colorized if colors is true. With OutputEncodingCBOR,
+// it returns the CBOR formatter instead.
+func newFormatter(encoding OutputEncoding, jsonEnabled bool, format JSONFormat, timestampFormat string, colors bool) logrus.Formatter {
+	if encoding == OutputEncodingCBOR {
+		return &cborFormatter{timestampFormat: timestampFormat, jsonEnabled: jsonEnabled}
+	}
+
+	if !jsonEnabled {
+		if colors {
+			return &prettyFormatter{}
 		}
-	} else {
-		formatter = &logrus.TextFormatter{ //nolint: exhaustruct
-			TimestampFormat: time.RFC3339Nano,
-			FieldMap:        fieldMap,
+		return &logrus.TextFormatter{ //nolint: exhaustruct
+			TimestampFormat: timestampFormat,
+			FieldMap:        formatterFieldMap,
 		}
 	}
 
-	l.logger.Logger.SetFormatter(formatter)
+	switch format {
+	case JSONFormatECS:
+		return &ecsFormatter{timestampFormat: timestampFormat}
+	case JSONFormatGCP:
+		return newGCPFormatter(timestampFormat)
+	case JSONFormatCLEF:
+		return &clefFormatter{timestampFormat: timestampFormat}
+	default:
+		return &logrus.JSONFormatter{ //nolint: exhaustruct
+			TimestampFormat: timestampFormat,
+			FieldMap:        formatterFieldMap,
+		}
+	}
 }
 
 // SetAppID sets app_id field in the log. Default value is empty string.
 func (l *daprLogger) SetAppID(id string) {
 	l.logger = l.logger.WithField(logFieldAppID, id)
+
+	// Replace the app ID of WithAppID, so that EnableJSONOutput keeps this one.
+	base := maps.Clone(l.core.baseFields)
+	if base == nil {
+		base = make(logrus.Fields, 1)
+	}
+	base[logFieldAppID] = id
+	l.core.baseFields = base
+}
+
+// SetComponentVersion sets the component_version field in the log.
+// Unlike DaprVersion, each logger can carry its own component version.
+func (l *daprLogger) SetComponentVersion(version string) {
+	l.logger = l.logger.WithField(logFieldComponentVer, version)
 }
 
 func toLogrusLevel(lvl LogLevel) logrus.Level {
@@ -95,82 +246,396 @@ func toLogrusLevel(lvl LogLevel) logrus.Level {
 }
 
 // SetOutputLevel sets log output level.
+// It replaces the levels set with SetEnabledLevels, if any.
 func (l *daprLogger) SetOutputLevel(outputLevel LogLevel) {
+	l.core.enabledLevels.Store(0)
 	l.logger.Logger.SetLevel(toLogrusLevel(outputLevel))
 }
 
 // IsOutputLevelEnabled returns true if the logger will output this LogLevel.
 func (l *daprLogger) IsOutputLevelEnabled(level LogLevel) bool {
-	return l.logger.Logger.IsLevelEnabled(toLogrusLevel(level))
+	return l.isLevelEnabled(toLogrusLevel(level))
+}
+
+// isLevelEnabled checks level against the explicitly enabled levels if any,
+// or against the output level otherwise.
+func (l *daprLogger) isLevelEnabled(level logrus.Level) bool {
+	if l.channel != "" {
+		if ch, ok := l.lookupChannel(l.channel); ok {
+			return level <= ch.level
+		}
+	}
+
+	if enabled := l.core.enabledLevels.Load(); enabled != 0 {
+		return enabled&levelBit(level) != 0
+	}
+
+	return l.logger.Logger.IsLevelEnabled(level)
 }
 
 // SetOutput sets the destination for the logs.
 func (l *daprLogger) SetOutput(dst io.Writer) {
+	l.core.lock.Lock()
 	l.logger.Logger.SetOutput(dst)
+	l.core.terminal = isTerminal(dst)
+	if !isJSONFormatter(l.logger.Logger.Formatter) {
+		l.logger.Logger.SetFormatter(l.newFormatterLocked(false))
+	}
+	l.core.lock.Unlock()
 }
 
 // WithLogType specify the log_type field in log. Default value is LogTypeLog.
 func (l *daprLogger) WithLogType(logType string) Logger {
-	return &daprLogger{
-		name:   l.name,
-		logger: l.logger.WithField(logFieldType, logType),
-	}
+	return l.derive(l.logger.WithField(logFieldType, logType))
 }
 
 // WithFields returns a logger with the added structured fields.
 func (l *daprLogger) WithFields(fields map[string]any) Logger {
+	return l.derive(l.logger.WithFields(fields))
+}
+
+// derive returns a logger sharing l's core that logs with the given entry.
+func (l *daprLogger) derive(entry *logrus.Entry) *daprLogger {
 	return &daprLogger{
-		name:   l.name,
-		logger: l.logger.WithFields(fields),
+		name:        l.name,
+		logger:      entry,
+		core:        l.core,
+		channel:     l.channel,
+		retry:       l.retry,
+		minSeverity: l.minSeverity,
 	}
 }
 
 // Info logs a message at level Info.
 func (l *daprLogger) Info(args ...any) {
-	l.logger.Log(logrus.InfoLevel, args...)
+	l.print(logrus.InfoLevel, args...)
 }
 
 // Infof logs a message at level Info.
 func (l *daprLogger) Infof(format string, args ...any) {
-	l.logger.Logf(logrus.InfoLevel, format, args...)
+	l.printf(logrus.InfoLevel, format, args...)
+}
+
+// Trace logs a message at level Trace.
+func (l *daprLogger) Trace(args ...any) {
+	l.print(logrus.TraceLevel, args...)
+}
+
+// Tracef logs a message at level Trace.
+func (l *daprLogger) Tracef(format string, args ...any) {
+	l.printf(logrus.TraceLevel, format, args...)
 }
 
 // Debug logs a message at level Debug.
 func (l *daprLogger) Debug(args ...any) {
-	l.logger.Log(logrus.DebugLevel, args...)
+	l.print(logrus.DebugLevel, args...)
 }
 
 // Debugf logs a message at level Debug.
 func (l *daprLogger) Debugf(format string, args ...any) {
-	l.logger.Logf(logrus.DebugLevel, format, args...)
+	l.printf(logrus.DebugLevel, format, args...)
 }
 
 // Warn logs a message at level Warn.
 func (l *daprLogger) Warn(args ...any) {
-	l.logger.Log(logrus.WarnLevel, args...)
+	l.print(logrus.WarnLevel, args...)
 }
 
 // Warnf logs a message at level Warn.
 func (l *daprLogger) Warnf(format string, args ...any) {
-	l.logger.Logf(logrus.WarnLevel, format, args...)
+	l.printf(logrus.WarnLevel, format, args...)
 }
 
 // Error logs a message at level Error.
 func (l *daprLogger) Error(args ...any) {
-	l.logger.Log(logrus.ErrorLevel, args...)
+	l.print(logrus.ErrorLevel, args...)
 }
 
 // Errorf logs a message at level Error.
 func (l *daprLogger) Errorf(format string, args ...any) {
-	l.logger.Logf(logrus.ErrorLevel, format, args...)
+	l.printf(logrus.ErrorLevel, format, args...)
 }
 
 // Fatal logs a message at level Fatal then the process will exit with status set to 1.
 func (l *daprLogger) Fatal(args ...any) {
-	l.logger.Fatal(args...)
+	l.print(logrus.FatalLevel, args...)
+	l.exit()
 }
 
 // Fatalf logs a message at level Fatal then the process will exit with status set to 1.
 func (l *daprLogger) Fatalf(format string, args ...any) {
-	l.logger.Fatalf(format, args...)
+	l.printf(logrus.FatalLevel, format, args...)
+	l.exit()
+}
+
+func (l *daprLogger) print(level logrus.Level, args ...any) {
+	if level, ok := l.enabledLevel(level); ok {
+		l.log(level, fmt.Sprint(args...))
+	}
+}
+
+func (l *daprLogger) printf(level logrus.Level, format string, args ...any) {
+	level, ok := l.enabledLevel(level)
+	if !ok {
+		return
+	}
+
+	if !hasWrapVerb(format) {
+		l.log(level, fmt.Sprintf(format, args...))
+		return
+	}
+
+	// fmt.Errorf formats the errors wrapped with %w like %v.
+	err := fmt.Errorf(format, args...)
+	if l.core.structuredErrors.Load() && len(wrappedErrors(err)) > 0 {
+		l.withError(err).log(level, err.Error())
+		return
+	}
+	l.log(level, err.Error())
+}
+
+// enabledLevel returns level raised to the minimum severity of the logger,
+// and true if the entries at the raised level are written.
+func (l *daprLogger) enabledLevel(level logrus.Level) (logrus.Level, bool) {
+	level = l.raiseLevel(level)
+	if l.retry != nil {
+		l.retry.record(level, l.core.clock.Now())
+	}
+
+	return level, l.isLevelEnabled(level)
+}
+
+// raiseLevel returns level raised to the minimum severity of the logger.
+func (l *daprLogger) raiseLevel(level logrus.Level) logrus.Level {
+	if l.minSeverity != 0 && level > l.minSeverity {
+		return l.minSeverity
+	}
+	return level
+}
+
+// log builds the entry for msg and writes it out.
+// The caller is responsible for checking the level is enabled.
+func (l *daprLogger) log(level logrus.Level, msg string) {
+	l.logAt(level, l.core.clock.Now(), msg)
+}
+
+// logAt is log for an entry at time now, such as the time of a slog record.
+func (l *daprLogger) logAt(level logrus.Level, now time.Time, msg string) {
+	s := l.core.sampler.Load()
+	if s != nil && !isGuaranteedLevel(level) && !s.sample() {
+		return
+	}
+
+	var sampledCount uint64
+	if ms := l.core.messageSampler.Load(); ms != nil && level > logrus.FatalLevel {
+		var ok bool
+		if ok, sampledCount = ms.sample(level, msg, now); !ok {
+			return
+		}
+	}
+
+	if !allowedByGlobalRateLimit(level <= logrus.ErrorLevel) {
+		return
+	}
+
+	entry := l.logger.Dup()
+	entry.Time = now
+	entry.Level = level
+	entry.Message = msg
+
+	if sampledCount > 0 {
+		entry.Data[logFieldSampledCount] = sampledCount
+	}
+
+	l.enrich(entry, !isGuaranteedLevel(level))
+
+	if r := l.core.repeatSuppressor.Load(); r != nil {
+		if level <= logrus.FatalLevel {
+			// Don't lose the summary when the process is about to exit.
+			r.flush(entry.Time)
+		} else if !r.allow(entry) {
+			return
+		}
+	}
+
+	if c := l.core.coalescer.Load(); c != nil {
+		if level > logrus.FatalLevel {
+			c.add(entry)
+			return
+		}
+		// Don't lose the held entry when the process is about to exit.
+		c.flush()
+	}
+
+	l.write(entry)
+}
+
+// enrich adds the fields of the logger to entry, redacts and filters them,
+// and converts their keys to the key case, for both the logged and the audit
+// entries. sampled is the sampled field, set if the logger samples entries.
+func (l *daprLogger) enrich(entry *logrus.Entry, sampled bool) {
+	addScopeDefaultFields(l.name, entry.Data)
+	addEnvironment(entry.Data)
+	addGlobalFields(entry.Data)
+	resolveLazyFields(entry.Data)
+	redact(entry)
+	l.core.fieldFilter.Load().filterFields(entry)
+
+	maxMessageBytes, maxFieldBytes := l.core.maxMessageBytes.Load(), l.core.maxFieldBytes.Load()
+	if (maxMessageBytes > 0 || maxFieldBytes > 0) && truncateEntry(entry, int(maxMessageBytes), int(maxFieldBytes)) {
+		entry.Data[logFieldTruncated] = true
+	}
+
+	if l.core.caller.Load() {
+		addCaller(entry.Data)
+	}
+
+	if l.core.sampler.Load() != nil {
+		entry.Data[logFieldSampled] = sampled
+	}
+
+	if v := *l.core.schemaVersion.Load(); v != "" {
+		entry.Data[logFieldSchemaVer] = v
+	}
+
+	isError := entry.Level <= logrus.ErrorLevel
+	if t := l.core.degradation.Load(); t != nil && t.observe(entry.Time, isError) && isError {
+		entry.Data[logFieldDegraded] = true
+	}
+
+	l.observeCardinality(entry.Data)
+
+	if p := l.core.partitioning.Load(); p != nil {
+		entry.Data[logFieldPartition] = p.partition(entry.Data)
+	}
+
+	if digits := l.core.floatPrecision.Load(); digits >= 0 {
+		roundFloatFields(entry.Data, int(digits))
+	}
+
+	if c := FieldKeyCase(l.core.fieldKeyCase.Load()); c != CaseAsIs {
+		entry.Data = convertFieldKeys(entry.Data, c)
+	}
+}
+
+// write formats entry, writes it to the output, exports it and notifies the
+// write callbacks.
+func (l *daprLogger) write(entry *logrus.Entry) {
+	onWrite, n, err := l.writeLocked(entry, false)
+	l.notifyWrite(entry, onWrite, n, err)
+}
+
+// notifyWrite exports entry and invokes the write callbacks, outside of the
+// logger lock.
+func (l *daprLogger) notifyWrite(entry *logrus.Entry, onWrite []func(Entry, int, error), n int, err error) {
+	exp := l.core.exporter.Load()
+	if len(onWrite) == 0 && exp == nil {
+		return
+	}
+
+	e := newEntry(entry)
+	if exp != nil {
+		exp.Export(e)
+	}
+	for _, fn := range onWrite {
+		fn(e, n, err)
+	}
+}
+
+// writeLocked writes entry to its output and to the sinks, and returns the
+// write callbacks. An audit entry is written to the audit writer if set, and
+// synced, and the write errors are returned instead of printed to stderr.
+func (l *daprLogger) writeLocked(entry *logrus.Entry, audit bool) ([]func(Entry, int, error), int, error) {
+	l.core.lock.Lock()
+	defer l.core.lock.Unlock()
+
+	if l.core.utc.Load() {
+		entry.Time = entry.Time.UTC()
+	}
+
+	toAuditWriter := audit && l.core.auditWriter != nil
+	if l.core.writeFunc != nil && !toAuditWriter {
+		return l.core.onWrite, 0, l.writeFuncLocked(newEntry(entry))
+	}
+
+	// In text mode the note is rendered as a trailing comment instead of a field.
+	note, hasNote := entry.Data[logFieldNote]
+	isText := !isJSONFormatter(entry.Logger.Formatter)
+	if hasNote && isText {
+		delete(entry.Data, logFieldNote)
+	}
+
+	formatted := entry
+	_, isDaprJSON := entry.Logger.Formatter.(*logrus.JSONFormatter)
+	if isDaprJSON && l.core.compactEnvelope.Load() {
+		// Compact a copy, so the retained entries and callbacks keep their envelope.
+		compacted := *entry
+		compacted.Data = maps.Clone(entry.Data)
+		compactEnvelope(compacted.Data)
+		formatted = &compacted
+	}
+
+	serialized, err := entry.Logger.Formatter.Format(formatted)
+	if err != nil {
+		if !audit {
+			fmt.Fprintf(os.Stderr, "Failed to obtain reader, %v\n", err)
+		}
+		return l.core.onWrite, 0, err
+	}
+
+	if hasNote && isText {
+		serialized = appendNote(serialized, fmt.Sprint(note))
+	}
+
+	if h := FrameHeader(l.core.frameHeader.Load()); h != FrameNone {
+		serialized = frame(h, serialized)
+	}
+
+	var n int
+	switch {
+	case toAuditWriter:
+		n, err = writeDurable(l.core.auditWriter, entry, serialized)
+	case audit:
+		n, err = writeDurable(l.outputLocked(entry), entry, serialized)
+	default:
+		n, err = writeOutput(l.outputLocked(entry), entry, serialized)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to write to log, %v\n", err)
+		}
+	}
+
+	l.writeSinksLocked(entry)
+
+	if l.core.retention != nil {
+		l.core.retention.add(newEntry(entry))
+	}
+
+	if l.core.auditedSink != nil && entry.Data[logFieldSensitivity] == SensitivityRestricted {
+		if _, aerr := l.core.auditedSink.Write(serialized); aerr != nil {
+			fmt.Fprintf(os.Stderr, "Failed to write to audited sink, %v\n", aerr)
+		}
+	}
+
+	return l.core.onWrite, n, err
+}
+
+// outputLocked returns the destination of entry: the channel of the entry,
+// the tenant sink, the output of the entry level or the logger output.
+// It must be called with the core lock held.
+func (l *daprLogger) outputLocked(entry *logrus.Entry) io.Writer {
+	if name, ok := entry.Data[logFieldChannel].(string); ok {
+		if ch, ok := l.lookupChannel(name); ok {
+			return ch.w
+		}
+	}
+
+	if w := l.tenantOutputLocked(entry.Data); w != nil {
+		return w
+	}
+
+	if w, ok := l.core.levelOutputs[entry.Level]; ok {
+		return w
+	}
+
+	return entry.Logger.Out
 }
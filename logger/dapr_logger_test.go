@@ -419,3 +419,27 @@ func TestToLogrusLevel(t *testing.T) {
 		assert.Equal(t, logrus.FatalLevel, toLogrusLevel(FatalLevel))
 	})
 }
+
+func TestSetComponentVersion(t *testing.T) {
+	var buf0, buf1 bytes.Buffer
+
+	logger0 := getTestLogger(&buf0)
+	logger0.EnableJSONOutput(true)
+	logger0.SetComponentVersion("v1.2.0")
+
+	logger1 := getTestLogger(&buf1)
+	logger1.SetComponentVersion("v2.0.1")
+	// Changing the format keeps the component version.
+	logger1.EnableJSONOutput(true)
+
+	logger0.Info("from component 0")
+	logger1.Info("from component 1")
+
+	var o0, o1 map[string]any
+	require.NoError(t, json.Unmarshal(buf0.Bytes(), &o0))
+	require.NoError(t, json.Unmarshal(buf1.Bytes(), &o1))
+
+	assert.Equal(t, "v1.2.0", o0[logFieldComponentVer])
+	assert.Equal(t, "v2.0.1", o1[logFieldComponentVer])
+	assert.Equal(t, o0[logFieldDaprVer], o1[logFieldDaprVer])
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"sync"
+	"time"
+)
+
+const logFieldDegraded = "degraded"
+
+// EnableDegradationTracking marks error entries with degraded=true while the
+// share of error entries logged within window exceeds threshold.
+// A window of zero or less disables the tracking.
+func (l *daprLogger) EnableDegradationTracking(window time.Duration, threshold float64) {
+	if window <= 0 {
+		l.core.degradation.Store(nil)
+		return
+	}
+
+	l.core.degradation.Store(newDegradationTracker(window, threshold))
+}
+
+const (
+	// degradationBucketWidth is the time span of the error and entry counts
+	// the window of a degradationTracker is made of.
+	degradationBucketWidth = time.Second
+	// degradationMaxBuckets caps the number of counts of a degradationTracker,
+	// the buckets are widened for longer windows.
+	degradationMaxBuckets = 3600
+)
+
+// degradationTracker computes the error rate of the entries logged within a
+// moving window, from the counts of the entries logged in each bucket of it.
+type degradationTracker struct {
+	lock      sync.Mutex
+	threshold float64
+	width     time.Duration
+	// buckets is a ring of the counts, indexed by the number of widths since
+	// the Unix epoch modulo its length
+	buckets []degradationBucket
+	// last is the index of the latest bucket
+	last   int64
+	total  int
+	errors int
+}
+
+type degradationBucket struct {
+	total  int
+	errors int
+}
+
+func newDegradationTracker(window time.Duration, threshold float64) *degradationTracker {
+	width := min(window, max(degradationBucketWidth, window/degradationMaxBuckets))
+
+	return &degradationTracker{
+		threshold: threshold,
+		width:     width,
+		buckets:   make([]degradationBucket, (window+width-1)/width),
+	}
+}
+
+// observe records an entry logged at the given time and returns true if the
+// error rate, including this entry, exceeds the threshold.
+func (t *degradationTracker) observe(at time.Time, isError bool) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	n := int64(len(t.buckets))
+	idx := at.UnixNano() / int64(t.width)
+	switch {
+	case idx > t.last:
+		// Expire the buckets that left the window.
+		for i := t.last + 1; i <= idx && i <= t.last+n; i++ {
+			b := &t.buckets[i%n]
+			t.total -= b.total
+			t.errors -= b.errors
+			*b = degradationBucket{}
+		}
+		t.last = idx
+	case idx <= t.last-n:
+		// Entries older than the window, such as relayed ones, are counted in
+		// the latest bucket.
+		idx = t.last
+	}
+
+	b := &t.buckets[idx%n]
+	b.total++
+	t.total++
+	if isError {
+		b.errors++
+		t.errors++
+	}
+
+	return float64(t.errors)/float64(t.total) > t.threshold
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestDegradationTracking(t *testing.T) {
+	var buf bytes.Buffer
+
+	clock := clocktesting.NewFakeClock(time.Now())
+	testLogger := getTestLogger(&buf)
+	testLogger.core.clock = clock
+	testLogger.EnableJSONOutput(true)
+	testLogger.EnableDegradationTracking(time.Minute, 0.5)
+
+	degraded := func() any {
+		t.Helper()
+
+		o := readTestEntry(t, &buf)
+
+		return o[logFieldDegraded]
+	}
+
+	t.Run("below threshold", func(t *testing.T) {
+		testLogger.Info("ok")
+		assert.Nil(t, degraded())
+		testLogger.Error("failure")
+		assert.Nil(t, degraded())
+	})
+
+	t.Run("threshold breached", func(t *testing.T) {
+		testLogger.Error("failure")
+		assert.Equal(t, true, degraded())
+		testLogger.Error("failure")
+		assert.Equal(t, true, degraded())
+	})
+
+	t.Run("info entries are never marked", func(t *testing.T) {
+		testLogger.Info("ok")
+		assert.Nil(t, degraded())
+	})
+
+	t.Run("rate drops", func(t *testing.T) {
+		for range 5 {
+			testLogger.Info("ok")
+			degraded()
+		}
+		testLogger.Error("failure")
+		assert.Nil(t, degraded())
+	})
+
+	t.Run("samples expire with the window", func(t *testing.T) {
+		// The info entries logged above no longer dilute the rate.
+		clock.Step(2 * time.Minute)
+		testLogger.Error("failure")
+		assert.Equal(t, true, degraded())
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		testLogger.EnableDegradationTracking(0, 0)
+		testLogger.Error("failure")
+		assert.Nil(t, degraded())
+	})
+}
+
+func TestDegradationTracker(t *testing.T) {
+	t.Run("buckets", func(t *testing.T) {
+		assert.Len(t, newDegradationTracker(time.Minute, 0.5).buckets, 60)
+		assert.Len(t, newDegradationTracker(24*time.Hour, 0.5).buckets, degradationMaxBuckets)
+		assert.Len(t, newDegradationTracker(100*time.Millisecond, 0.5).buckets, 1)
+	})
+
+	t.Run("memory doesn't grow with the entries", func(t *testing.T) {
+		tracker := newDegradationTracker(time.Minute, 0.5)
+		now := time.Now()
+		for i := range 10000 {
+			tracker.observe(now.Add(time.Duration(i)*time.Millisecond), i%2 == 0)
+		}
+		assert.Len(t, tracker.buckets, 60)
+		assert.Equal(t, 10000, tracker.total)
+	})
+
+	t.Run("buckets expire one at a time", func(t *testing.T) {
+		tracker := newDegradationTracker(time.Minute, 0.5)
+		now := time.Now()
+
+		assert.True(t, tracker.observe(now, true))
+		assert.False(t, tracker.observe(now.Add(30*time.Second), false))
+		// The error is still in the window.
+		assert.False(t, tracker.observe(now.Add(45*time.Second), false))
+		// The first error left the window.
+		assert.False(t, tracker.observe(now.Add(80*time.Second), true))
+		assert.Equal(t, 3, tracker.total)
+		assert.Equal(t, 1, tracker.errors)
+	})
+
+	t.Run("entries older than the window", func(t *testing.T) {
+		tracker := newDegradationTracker(time.Minute, 0.5)
+		now := time.Now()
+
+		tracker.observe(now, false)
+		tracker.observe(now.Add(-time.Hour), true)
+		assert.Equal(t, 2, tracker.total)
+		assert.Equal(t, 1, tracker.errors)
+	})
+}
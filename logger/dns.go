@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"time"
+)
+
+const (
+	logFieldDurationMs   = "duration_ms"
+	logFieldDNSHost      = "dns_host"
+	logFieldDNSAddresses = "dns_addresses"
+)
+
+// LogDNSResolution logs the outcome of resolving host.
+// Successful resolutions are logged at level Debug, failures at level Warn.
+func (l *daprLogger) LogDNSResolution(host string, addrs []string, duration time.Duration, err error) {
+	fields := map[string]any{
+		logFieldDNSHost:      host,
+		logFieldDNSAddresses: addrs,
+		logFieldDurationMs:   toMilliseconds(duration),
+	}
+
+	if err != nil {
+		l.WithError(err).WithFields(fields).Warnf("Failed to resolve %s", host)
+		return
+	}
+
+	l.WithFields(fields).Debugf("Resolved %s", host)
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogDNSResolution(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+		testLogger.SetOutputLevel(DebugLevel)
+
+		testLogger.LogDNSResolution("example.com", []string{"10.0.0.1", "10.0.0.2"}, 12*time.Millisecond, nil)
+
+		o := readTestEntry(t, &buf)
+
+		assert.Equal(t, "debug", o[logFieldLevel])
+		assert.Equal(t, "example.com", o[logFieldDNSHost])
+		assert.Equal(t, []any{"10.0.0.1", "10.0.0.2"}, o[logFieldDNSAddresses])
+		assert.InDelta(t, float64(12), o[logFieldDurationMs], 0.001)
+		assert.NotContains(t, o, logFieldError)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+
+		testLogger.LogDNSResolution("example.com", nil, 250*time.Millisecond, errors.New("no such host"))
+
+		o := readTestEntry(t, &buf)
+
+		assert.Equal(t, "warning", o[logFieldLevel])
+		assert.Equal(t, "example.com", o[logFieldDNSHost])
+		assert.Nil(t, o[logFieldDNSAddresses])
+		assert.InDelta(t, float64(250), o[logFieldDurationMs], 0.001)
+		assert.Equal(t, "no such host", o[logFieldError])
+	})
+}
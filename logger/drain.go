@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"time"
+)
+
+const (
+	logFieldListener           = "listener"
+	logFieldActiveConnections  = "active_connections"
+	logFieldDrainedConnections = "drained_connections"
+	logFieldDrainDeadline      = "drain_deadline"
+)
+
+// LogDrain logs the progress of draining the connections of listener before
+// shutting down, at level Info, or at level Warn once deadline has passed with
+// connections still active.
+func (l *daprLogger) LogDrain(listener string, active, drained int, deadline time.Time) {
+	log := l.WithFields(map[string]any{
+		logFieldListener:           listener,
+		logFieldActiveConnections:  active,
+		logFieldDrainedConnections: drained,
+		logFieldDrainDeadline:      deadline.Format(time.RFC3339Nano),
+	})
+
+	if active > 0 && l.core.clock.Now().After(deadline) {
+		log.Warnf("Drain deadline of %s passed with %d active connections", listener, active)
+		return
+	}
+
+	log.Infof("Draining %s: %d connections drained, %d active", listener, drained, active)
+}
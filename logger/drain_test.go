@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestLogDrain(t *testing.T) {
+	var buf bytes.Buffer
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	clock := clocktesting.NewFakeClock(now)
+	deadline := now.Add(5 * time.Second)
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.core.clock = clock
+
+	t.Run("draining", func(t *testing.T) {
+		testLogger.LogDrain("grpc-api", 3, 7, deadline)
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "info", o[logFieldLevel])
+		assert.Equal(t, "grpc-api", o[logFieldListener])
+		assert.InDelta(t, float64(3), o[logFieldActiveConnections], 0)
+		assert.InDelta(t, float64(7), o[logFieldDrainedConnections], 0)
+		assert.Equal(t, "2026-03-01T12:00:05Z", o[logFieldDrainDeadline])
+	})
+
+	clock.Step(10 * time.Second)
+
+	t.Run("deadline passed", func(t *testing.T) {
+		testLogger.LogDrain("grpc-api", 1, 9, deadline)
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "warning", o[logFieldLevel])
+		assert.InDelta(t, float64(1), o[logFieldActiveConnections], 0)
+	})
+
+	t.Run("drained after the deadline", func(t *testing.T) {
+		testLogger.LogDrain("grpc-api", 0, 10, deadline)
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "info", o[logFieldLevel])
+	})
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ecsVersion is the version of the Elastic Common Schema of JSONFormatECS.
+const ecsVersion = "8.11.0"
+
+// ecsFieldNames maps the Dapr fields to their ECS field. The other fields are
+// kept as is.
+var ecsFieldNames = map[string]string{
+	logFieldScope:      "log.logger",
+	logFieldAppID:      "service.name",
+	logFieldDaprVer:    "service.version",
+	logFieldInstance:   "host.name",
+	logFieldType:       "event.dataset",
+	logFieldTraceID:    "trace.id",
+	logFieldSpanID:     "span.id",
+	logFieldError:      "error.message",
+	logFieldErrorType:  "error.type",
+	logFieldStackTrace: "error.stack_trace",
+	logFieldFunc:       "log.origin.function",
+}
+
+// ecsFormatter formats the entries as ECS JSON.
+type ecsFormatter struct {
+	timestampFormat string
+}
+
+// Format implements logrus.Formatter.
+func (f *ecsFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	data := make(map[string]any, len(entry.Data)+4)
+	for k, v := range entry.Data {
+		// The caller field is file:line.
+		if caller, ok := v.(string); ok && k == logFieldCaller {
+			file, line, _ := strings.Cut(caller, ":")
+			data["log.origin.file.name"] = file
+			if n, err := strconv.Atoi(line); err == nil {
+				data["log.origin.file.line"] = n
+			}
+			continue
+		}
+
+		if name, ok := ecsFieldNames[k]; ok {
+			k = name
+		}
+		data[k] = jsonFieldValue(v)
+	}
+
+	data["@timestamp"] = entry.Time.Format(f.timestampFormat)
+	data["log.level"] = string(fromLogrusLevel(entry.Level))
+	data["message"] = entry.Message
+	data["ecs.version"] = ecsVersion
+
+	return marshalJSONEntry(data)
+}
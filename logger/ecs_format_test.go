@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestECSFormat(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	testLogger := getTestLogger(&buf)
+	testLogger.core.clock = clocktesting.NewFakeClock(now)
+	testLogger.EnableJSONOutput(true)
+	testLogger.SetJSONFormat(JSONFormatECS)
+	testLogger.SetAppID("dapr-app")
+	assert.True(t, isJSONEnabled(testLogger))
+
+	testLogger.WithError(errors.New("boom")).
+		WithFields(map[string]any{"component": "statestore"}).
+		Warn("failed to save state")
+
+	o := readTestEntry(t, &buf)
+	assert.Equal(t, "2026-03-01T12:30:00Z", o["@timestamp"])
+	assert.Equal(t, "warn", o["log.level"])
+	assert.Equal(t, fakeLoggerName, o["log.logger"])
+	assert.Equal(t, "failed to save state", o["message"])
+	assert.Equal(t, "dapr-app", o["service.name"])
+	assert.Equal(t, "boom", o["error.message"])
+	assert.Equal(t, "statestore", o["component"])
+	assert.Equal(t, ecsVersion, o["ecs.version"])
+	assert.NotContains(t, o, logFieldScope)
+	assert.NotContains(t, o, logFieldMessage)
+
+	t.Run("caller", func(t *testing.T) {
+		buf.Reset()
+		testLogger.EnableCaller(true)
+		defer testLogger.EnableCaller(false)
+
+		testLogger.Info("with caller")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "ecs_format_test.go", o["log.origin.file.name"])
+		assert.Greater(t, o["log.origin.file.line"], float64(0))
+		assert.Contains(t, o["log.origin.function"], "TestECSFormat")
+	})
+
+	t.Run("back to the default format", func(t *testing.T) {
+		buf.Reset()
+		testLogger.SetJSONFormat(JSONFormatDefault)
+		testLogger.Info("default")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "default", o[logFieldMessage])
+	})
+}
+
+func TestToJSONFormat(t *testing.T) {
+	f, ok := toJSONFormat("ECS")
+	assert.True(t, ok)
+	assert.Equal(t, JSONFormatECS, f)
+
+	f, ok = toJSONFormat("")
+	assert.True(t, ok)
+	assert.Equal(t, JSONFormatDefault, f)
+
+	_, ok = toJSONFormat("logfmt")
+	assert.False(t, ok)
+}
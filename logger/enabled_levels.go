@@ -0,0 +1,38 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+// SetEnabledLevels sets the exact levels the logger outputs, as an alternative
+// to the threshold set with SetOutputLevel. For example, enabling DebugLevel
+// and ErrorLevel drops Info and Warn entries.
+// Calling it without levels, or calling SetOutputLevel, restores the threshold.
+func (l *daprLogger) SetEnabledLevels(levels ...LogLevel) {
+	var enabled uint32
+	for _, lvl := range levels {
+		if lvl == UndefinedLevel {
+			continue
+		}
+		enabled |= levelBit(toLogrusLevel(lvl))
+	}
+
+	l.core.enabledLevels.Store(enabled)
+}
+
+func levelBit(lvl logrus.Level) uint32 {
+	return 1 << uint32(lvl)
+}
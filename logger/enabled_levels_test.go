@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetEnabledLevels(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.SetOutputLevel(InfoLevel)
+	testLogger.SetEnabledLevels(DebugLevel, ErrorLevel)
+
+	expected := map[LogLevel]bool{
+		DebugLevel: true,
+		InfoLevel:  false,
+		WarnLevel:  false,
+		ErrorLevel: true,
+		FatalLevel: false,
+	}
+	for lvl, want := range expected {
+		assert.Equalf(t, want, testLogger.IsOutputLevelEnabled(lvl), "level %s", lvl)
+	}
+
+	testLogger.Debug("debug")
+	assert.Contains(t, buf.String(), "msg=debug")
+	buf.Reset()
+
+	testLogger.Info("info")
+	testLogger.Warn("warn")
+	assert.Empty(t, buf.String())
+
+	testLogger.Error("error")
+	assert.Contains(t, buf.String(), "msg=error")
+	buf.Reset()
+
+	// Derived loggers share the enabled levels.
+	testLogger.WithFields(map[string]any{"a": 1}).Info("info")
+	assert.Empty(t, buf.String())
+
+	t.Run("no levels restores the threshold", func(t *testing.T) {
+		testLogger.SetEnabledLevels()
+		assert.False(t, testLogger.IsOutputLevelEnabled(DebugLevel))
+		assert.True(t, testLogger.IsOutputLevelEnabled(InfoLevel))
+	})
+
+	t.Run("SetOutputLevel restores the threshold", func(t *testing.T) {
+		testLogger.SetEnabledLevels(ErrorLevel)
+		assert.False(t, testLogger.IsOutputLevelEnabled(WarnLevel))
+
+		testLogger.SetOutputLevel(WarnLevel)
+		assert.True(t, testLogger.IsOutputLevelEnabled(WarnLevel))
+		assert.True(t, testLogger.IsOutputLevelEnabled(ErrorLevel))
+		assert.False(t, testLogger.IsOutputLevelEnabled(InfoLevel))
+	})
+}
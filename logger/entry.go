@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"maps"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Entry is a log entry, independent of the underlying logging library.
+type Entry struct {
+	// Time is when the entry was logged.
+	Time time.Time
+	// Level is the level the entry was logged at.
+	Level LogLevel
+	// Scope is the name of the logger.
+	Scope string
+	// Type is the log type, such as LogTypeLog or LogTypeRequest.
+	Type string
+	// Message is the log message.
+	Message string
+	// Fields holds the structured fields of the entry, including the
+	// standard ones such as scope and instance.
+	Fields map[string]any
+}
+
+// newEntry converts a logrus entry to an Entry.
+func newEntry(e *logrus.Entry) Entry {
+	scope, _ := e.Data[logFieldScope].(string)
+	logType, _ := e.Data[logFieldType].(string)
+
+	return Entry{
+		Time:    e.Time,
+		Level:   fromLogrusLevel(e.Level),
+		Scope:   scope,
+		Type:    logType,
+		Message: e.Message,
+		Fields:  maps.Clone(map[string]any(e.Data)),
+	}
+}
+
+// fromLogrusLevel converts a logrus level to a LogLevel.
+func fromLogrusLevel(lvl logrus.Level) LogLevel {
+	switch lvl {
+	case logrus.TraceLevel:
+		return TraceLevel
+	case logrus.DebugLevel:
+		return DebugLevel
+	case logrus.InfoLevel:
+		return InfoLevel
+	case logrus.WarnLevel:
+		return WarnLevel
+	case logrus.ErrorLevel:
+		return ErrorLevel
+	case logrus.FatalLevel:
+		return FatalLevel
+	case logrus.PanicLevel:
+		return PanicLevel
+	default:
+		return UndefinedLevel
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"time"
+
+	kclock "k8s.io/utils/clock"
+)
+
+const logFieldBuildMs = "build_ms"
+
+// EntryTimer measures how long building a log entry took.
+type EntryTimer struct {
+	logger Logger
+	clock  kclock.PassiveClock
+	start  time.Time
+}
+
+// NewEntryTimer returns a timer started now, whose entries carry the elapsed
+// time in the build_ms field.
+func (l *daprLogger) NewEntryTimer() *EntryTimer {
+	return &EntryTimer{
+		logger: l,
+		clock:  l.core.clock,
+		start:  l.core.clock.Now(),
+	}
+}
+
+// LogInfo logs msg at level Info with the milliseconds elapsed since the
+// timer was created.
+func (t *EntryTimer) LogInfo(msg string) {
+	t.logger.WithFields(map[string]any{
+		logFieldBuildMs: toMilliseconds(t.clock.Since(t.start)),
+	}).Info(msg)
+}
+
+// toMilliseconds returns d as fractional milliseconds.
+func toMilliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestEntryTimer(t *testing.T) {
+	var buf bytes.Buffer
+
+	clock := clocktesting.NewFakeClock(time.Now())
+	testLogger := getTestLogger(&buf)
+	testLogger.core.clock = clock
+	testLogger.EnableJSONOutput(true)
+
+	timer := testLogger.NewEntryTimer()
+	clock.Step(150 * time.Millisecond)
+	timer.LogInfo("subject built")
+
+	o := readTestEntry(t, &buf)
+
+	assert.Equal(t, "subject built", o[logFieldMessage])
+	assert.InDelta(t, float64(150), o[logFieldBuildMs], 0.001)
+}
+
+func TestEntryTimerRealClock(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	timer := testLogger.NewEntryTimer()
+	time.Sleep(20 * time.Millisecond)
+	timer.LogInfo("subject built")
+
+	o := readTestEntry(t, &buf)
+
+	assert.GreaterOrEqual(t, o[logFieldBuildMs], float64(20))
+	assert.Less(t, o[logFieldBuildMs], float64(1000))
+}
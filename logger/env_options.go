@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	// EnvLogLevel is the environment variable of Options.OutputLevel.
+	EnvLogLevel = "DAPR_LOG_LEVEL"
+	// EnvLogAsJSON is the environment variable of Options.JSONFormatEnabled.
+	EnvLogAsJSON = "DAPR_LOG_AS_JSON"
+	// EnvLogFormat is the environment variable of the output format, "text",
+	// "json", or one of the JSON layouts of Options.JSONFormat.
+	EnvLogFormat = "DAPR_LOG_FORMAT"
+	// EnvLogEncoding is the environment variable of Options.OutputEncoding.
+	EnvLogEncoding = "DAPR_LOG_ENCODING"
+	// EnvLogOutput is the environment variable of the output, "stdout", one
+	// of the targets of Options.OutputTarget, or else the path of
+	// Options.OutputFile.
+	EnvLogOutput = "DAPR_LOG_OUTPUT"
+
+	envLogFormatText   = "text"
+	envLogFormatJSON   = "json"
+	envLogOutputStdout = "stdout"
+)
+
+// OptionsFromEnv returns the default options overridden with the environment
+// variables, see ApplyEnv.
+func OptionsFromEnv() (Options, error) {
+	o := DefaultOptions()
+	if err := o.ApplyEnv(); err != nil {
+		return Options{}, err
+	}
+
+	return o, nil
+}
+
+// ApplyEnv overrides the options with the environment variables which are set
+// and not empty, so that the environment takes precedence over the flags and
+// the programmatic settings when called last:
+//
+//	DAPR_LOG_LEVEL    sets OutputLevel, a level or a spec such as "info,components.state:debug"
+//	DAPR_LOG_AS_JSON  sets JSONFormatEnabled, "true" or "false"
+//	DAPR_LOG_FORMAT   sets JSONFormatEnabled and JSONFormat, "text", "json", "ecs", "gcp" or "clef"
+//	DAPR_LOG_ENCODING sets OutputEncoding, "text" or "cbor"
+//	DAPR_LOG_OUTPUT   sets OutputTarget or OutputFile, "stdout", "syslog://host:514", "journald", "eventlog://source" or a file path
+//
+// DAPR_LOG_FORMAT takes precedence over DAPR_LOG_AS_JSON.
+func (o *Options) ApplyEnv() error {
+	if v := os.Getenv(EnvLogLevel); v != "" {
+		if err := o.SetOutputLevel(v); err != nil {
+			return fmt.Errorf("invalid value for %s: %w", EnvLogLevel, err)
+		}
+	}
+
+	if v := os.Getenv(EnvLogAsJSON); v != "" {
+		enabled, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %s", EnvLogAsJSON, v)
+		}
+		o.JSONFormatEnabled = enabled
+	}
+
+	if v := os.Getenv(EnvLogFormat); v != "" {
+		enabled, format, ok := parseLogFormat(v)
+		if !ok {
+			return fmt.Errorf("invalid value for %s: %s", EnvLogFormat, v)
+		}
+		o.JSONFormatEnabled = enabled
+		o.JSONFormat = string(format)
+	}
+
+	if v := os.Getenv(EnvLogEncoding); v != "" {
+		encoding, ok := toOutputEncoding(v)
+		if !ok {
+			return fmt.Errorf("invalid value for %s: %s", EnvLogEncoding, v)
+		}
+		o.OutputEncoding = string(encoding)
+	}
+
+	if v := os.Getenv(EnvLogOutput); v != "" {
+		o.setOutput(v)
+	}
+
+	return nil
+}
+
+// parseLogFormat returns whether format, "text", "json" or one of the JSON
+// layouts, is JSON and its layout. It returns false if format is invalid.
+func parseLogFormat(format string) (bool, JSONFormat, bool) {
+	switch strings.ToLower(format) {
+	case envLogFormatText:
+		return false, JSONFormatDefault, true
+	case envLogFormatJSON:
+		return true, JSONFormatDefault, true
+	default:
+		f, ok := toJSONFormat(format)
+		return ok, f, ok
+	}
+}
+
+// setOutput sets OutputTarget or OutputFile to output, "stdout", one of the
+// targets of OutputTarget, or else the path of OutputFile.
+func (o *Options) setOutput(output string) {
+	o.OutputTarget = ""
+	o.OutputFile = ""
+
+	switch {
+	case output == envLogOutputStdout:
+	case output == outputTargetJournald, strings.HasPrefix(output, outputTargetEventLog), isSyslogTarget(output):
+		o.OutputTarget = output
+	default:
+		o.OutputFile = output
+	}
+}
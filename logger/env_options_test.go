@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionsFromEnv(t *testing.T) {
+	t.Run("defaults without environment", func(t *testing.T) {
+		o, err := OptionsFromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, DefaultOptions(), o)
+	})
+
+	t.Run("all variables", func(t *testing.T) {
+		t.Setenv(EnvLogLevel, "warn,components.state:debug")
+		t.Setenv(EnvLogAsJSON, "false")
+		t.Setenv(EnvLogFormat, "ECS")
+		t.Setenv(EnvLogOutput, "syslog://127.0.0.1:514")
+
+		o, err := OptionsFromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, "warn,components.state:debug", o.OutputLevel)
+		assert.True(t, o.JSONFormatEnabled)
+		assert.Equal(t, "ecs", o.JSONFormat)
+		assert.Equal(t, "syslog://127.0.0.1:514", o.OutputTarget)
+		assert.Empty(t, o.OutputFile)
+	})
+
+	t.Run("json and text formats", func(t *testing.T) {
+		t.Setenv(EnvLogFormat, "json")
+		o, err := OptionsFromEnv()
+		require.NoError(t, err)
+		assert.True(t, o.JSONFormatEnabled)
+		assert.Empty(t, o.JSONFormat)
+
+		t.Setenv(EnvLogAsJSON, "true")
+		t.Setenv(EnvLogFormat, "text")
+		o, err = OptionsFromEnv()
+		require.NoError(t, err)
+		assert.False(t, o.JSONFormatEnabled)
+	})
+
+	t.Run("cbor encoding", func(t *testing.T) {
+		t.Setenv(EnvLogEncoding, "CBOR")
+		o, err := OptionsFromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, string(OutputEncodingCBOR), o.OutputEncoding)
+
+		t.Setenv(EnvLogFormat, "cbor")
+		_, err = OptionsFromEnv()
+		require.ErrorContains(t, err, EnvLogFormat)
+	})
+
+	t.Run("environment overrides programmatic settings", func(t *testing.T) {
+		t.Setenv(EnvLogAsJSON, "1")
+		t.Setenv(EnvLogOutput, "/var/log/dapr.log")
+
+		o := DefaultOptions()
+		o.OutputLevel = "debug"
+		o.OutputTarget = "journald"
+		require.NoError(t, o.ApplyEnv())
+
+		assert.Equal(t, "debug", o.OutputLevel)
+		assert.True(t, o.JSONFormatEnabled)
+		assert.Empty(t, o.OutputTarget)
+		assert.Equal(t, "/var/log/dapr.log", o.OutputFile)
+
+		t.Setenv(EnvLogOutput, "stdout")
+		require.NoError(t, o.ApplyEnv())
+		assert.Empty(t, o.OutputFile)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		for name, value := range map[string]string{
+			EnvLogLevel:    "verbose",
+			EnvLogAsJSON:   "maybe",
+			EnvLogFormat:   "logfmt",
+			EnvLogEncoding: "msgpack",
+		} {
+			t.Run(name, func(t *testing.T) {
+				t.Setenv(name, value)
+				_, err := OptionsFromEnv()
+				require.ErrorContains(t, err, name)
+			})
+		}
+	})
+}
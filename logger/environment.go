@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"os"
+	"sync/atomic"
+
+	"github.com/sirupsen/logrus"
+)
+
+const logFieldEnvironment = "env"
+
+// DefaultEnvironmentVar is the default environment variable read by
+// AutoDetectEnvironment.
+const DefaultEnvironmentVar = "DAPR_ENV"
+
+var (
+	// environment is the value of the env field, empty to omit it.
+	environment atomic.Pointer[string]
+	// environmentVar is the environment variable read by AutoDetectEnvironment.
+	environmentVar atomic.Pointer[string]
+)
+
+// SetEnvironment sets the env field of the entries of all loggers, such as
+// dev, staging or prod. An empty env omits the field.
+func SetEnvironment(env string) {
+	environment.Store(&env)
+}
+
+// SetEnvironmentVar sets the environment variable read by
+// AutoDetectEnvironment. Default value is DefaultEnvironmentVar.
+func SetEnvironmentVar(name string) {
+	environmentVar.Store(&name)
+}
+
+// AutoDetectEnvironment sets the env field of the entries of all loggers from
+// the environment variable set with SetEnvironmentVar, DAPR_ENV by default.
+// It leaves the environment unchanged if the variable is not set.
+func AutoDetectEnvironment() {
+	name := DefaultEnvironmentVar
+	if v := environmentVar.Load(); v != nil {
+		name = *v
+	}
+
+	if env, ok := os.LookupEnv(name); ok {
+		SetEnvironment(env)
+	}
+}
+
+// addEnvironment adds the env field to data, unless already set.
+func addEnvironment(data logrus.Fields) {
+	env := environment.Load()
+	if env == nil || *env == "" {
+		return
+	}
+
+	if _, ok := data[logFieldEnvironment]; !ok {
+		data[logFieldEnvironment] = *env
+	}
+}
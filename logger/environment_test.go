@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvironment(t *testing.T) {
+	t.Cleanup(func() {
+		SetEnvironment("")
+		SetEnvironmentVar(DefaultEnvironmentVar)
+	})
+
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	envOf := func(l Logger) any {
+		l.Info("hello")
+
+		o := readTestEntry(t, &buf)
+
+		return o[logFieldEnvironment]
+	}
+
+	t.Run("not set", func(t *testing.T) {
+		assert.Nil(t, envOf(testLogger))
+	})
+
+	t.Run("explicit", func(t *testing.T) {
+		SetEnvironment("staging")
+
+		assert.Equal(t, "staging", envOf(testLogger))
+		assert.Equal(t, "staging", envOf(testLogger.WithFields(map[string]any{"attempt": 1})))
+	})
+
+	t.Run("default variable", func(t *testing.T) {
+		t.Setenv(DefaultEnvironmentVar, "prod")
+		AutoDetectEnvironment()
+
+		assert.Equal(t, "prod", envOf(testLogger))
+	})
+
+	t.Run("configured variable", func(t *testing.T) {
+		t.Setenv("APP_ENV", "dev")
+		SetEnvironmentVar("APP_ENV")
+		AutoDetectEnvironment()
+
+		assert.Equal(t, "dev", envOf(testLogger))
+	})
+
+	t.Run("variable not set", func(t *testing.T) {
+		SetEnvironmentVar("LOGGER_TEST_UNSET_ENV")
+		AutoDetectEnvironment()
+
+		assert.Equal(t, "dev", envOf(testLogger))
+	})
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+const (
+	logFieldError       = "error"
+	logFieldErrorType   = "error_type"
+	logFieldErrorCauses = "error_causes"
+	// logFieldErrorCausesStructured replaces error_causes when enabled with
+	// EnableStructuredErrors.
+	logFieldErrorCausesStructured = "error.causes"
+	logFieldStackTrace            = "stacktrace"
+
+	// stackTraceMaxDepth is the number of frames captured in stacktrace.
+	stackTraceMaxDepth = 64
+)
+
+// WithError returns a logger with err in the error field, its Go type in the
+// error_type field and the messages of the errors it wraps, outermost first,
+// in the error_causes field. When enabled with EnableStackTrace, the stack of
+// the caller of WithError is added in the stacktrace field.
+// When enabled with EnableStructuredErrors, the messages and types of the
+// wrapped errors are added in the error.causes field instead of error_causes.
+// A nil err returns the logger unchanged.
+func (l *daprLogger) WithError(err error) Logger {
+	if err == nil {
+		return l
+	}
+
+	return l.withError(err)
+}
+
+// withError is WithError for a non-nil err.
+func (l *daprLogger) withError(err error) *daprLogger {
+	fields := map[string]any{
+		logFieldError:     err.Error(),
+		logFieldErrorType: fmt.Sprintf("%T", err),
+	}
+
+	if wrapped := wrappedErrors(err); len(wrapped) > 0 {
+		if l.core.structuredErrors.Load() {
+			fields[logFieldErrorCausesStructured] = structuredErrorCauses(wrapped)
+		} else {
+			fields[logFieldErrorCauses] = errorCauses(wrapped)
+		}
+	}
+
+	if l.core.stackTrace.Load() {
+		fields[logFieldStackTrace] = stackTrace()
+	}
+
+	return l.derive(l.logger.WithFields(fields))
+}
+
+// EnableStackTrace enables adding the stack of the caller of WithError in the
+// stacktrace field.
+func (l *daprLogger) EnableStackTrace(enabled bool) {
+	l.core.stackTrace.Store(enabled)
+}
+
+// EnableStructuredErrors replaces the error_causes field of WithError with the
+// error.causes array of the messages and types of the wrapped errors, so the
+// root causes can be queried. The f-functions also add the error fields of
+// the errors wrapped with %w.
+func (l *daprLogger) EnableStructuredErrors(enabled bool) {
+	l.core.structuredErrors.Store(enabled)
+}
+
+// hasWrapVerb returns true if format has a %w verb, such as %w or %[2]w, and
+// not an escaped %%w.
+func hasWrapVerb(format string) bool {
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+
+		// Skip the flags, argument indexes, width and precision.
+		i++
+		for i < len(format) && strings.IndexByte("+-# 0123456789.*[]", format[i]) >= 0 {
+			i++
+		}
+		if i < len(format) && format[i] == 'w' {
+			return true
+		}
+	}
+
+	return false
+}
+
+// errorCause is an element of the error.causes field.
+type errorCause struct {
+	Message string `json:"message"`
+	Type    string `json:"type"`
+}
+
+// wrappedErrors returns the errors wrapped by err, depth first.
+func wrappedErrors(err error) []error {
+	var errs []error
+
+	var walk func(err error)
+	walk = func(err error) {
+		var wrapped []error
+		switch e := err.(type) { //nolint:errorlint
+		case interface{ Unwrap() error }:
+			if u := e.Unwrap(); u != nil {
+				wrapped = []error{u}
+			}
+		case interface{ Unwrap() []error }:
+			wrapped = e.Unwrap()
+		}
+
+		for _, w := range wrapped {
+			if w == nil {
+				continue
+			}
+			errs = append(errs, w)
+			walk(w)
+		}
+	}
+	walk(err)
+
+	return errs
+}
+
+// errorCauses returns the messages of errs.
+func errorCauses(errs []error) []string {
+	causes := make([]string, len(errs))
+	for i, err := range errs {
+		causes[i] = err.Error()
+	}
+
+	return causes
+}
+
+// structuredErrorCauses returns the messages and types of errs.
+func structuredErrorCauses(errs []error) []errorCause {
+	causes := make([]errorCause, len(errs))
+	for i, err := range errs {
+		causes[i] = errorCause{Message: err.Error(), Type: fmt.Sprintf("%T", err)}
+	}
+
+	return causes
+}
+
+// stackTrace returns the stack of the first caller outside of this package,
+// formatted like the stack of a panic.
+func stackTrace() string {
+	pcs := make([]uintptr, stackTraceMaxDepth)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+
+	var (
+		b     strings.Builder
+		found bool
+	)
+	for {
+		frame, more := frames.Next()
+		if found || !isLoggerFrame(frame) {
+			found = true
+			b.WriteString(frame.Function)
+			b.WriteString("\n\t")
+			b.WriteString(frame.File)
+			b.WriteByte(':')
+			b.WriteString(strconv.Itoa(frame.Line))
+			b.WriteByte('\n')
+		}
+
+		if !more {
+			return b.String()
+		}
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithError(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	t.Run("nil error", func(t *testing.T) {
+		testLogger.WithError(nil).Info("no error")
+
+		o := readTestEntry(t, &buf)
+		assert.NotContains(t, o, logFieldError)
+		assert.NotContains(t, o, logFieldErrorType)
+	})
+
+	t.Run("wrapped error", func(t *testing.T) {
+		err := fmt.Errorf("failed to load config: %w", fmt.Errorf("open file: %w", fs.ErrNotExist))
+		testLogger.WithError(err).Error("startup failed")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "failed to load config: open file: file does not exist", o[logFieldError])
+		assert.Equal(t, "*fmt.wrapError", o[logFieldErrorType])
+		assert.Equal(t, []any{"open file: file does not exist", "file does not exist"}, o[logFieldErrorCauses])
+		assert.NotContains(t, o, logFieldStackTrace)
+	})
+
+	t.Run("joined errors", func(t *testing.T) {
+		err := errors.Join(errors.New("first"), fmt.Errorf("second: %w", errors.New("cause")))
+		testLogger.WithError(err).Error("several failures")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "first\nsecond: cause", o[logFieldError])
+		assert.Equal(t, []any{"first", "second: cause", "cause"}, o[logFieldErrorCauses])
+	})
+
+	t.Run("unwrapped error", func(t *testing.T) {
+		testLogger.WithError(errors.New("plain")).Error("failure")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "*errors.errorString", o[logFieldErrorType])
+		assert.NotContains(t, o, logFieldErrorCauses)
+	})
+
+	t.Run("stack trace", func(t *testing.T) {
+		testLogger.EnableStackTrace(true)
+		t.Cleanup(func() { testLogger.EnableStackTrace(false) })
+
+		testLogger.WithError(errors.New("plain")).Error("failure")
+
+		stack, ok := readTestEntry(t, &buf)[logFieldStackTrace].(string)
+		require.True(t, ok)
+		assert.Contains(t, stack, "logger.TestWithError.func")
+		assert.Contains(t, stack, "error_test.go:")
+		assert.NotContains(t, stack, "(*daprLogger).WithError")
+	})
+
+	t.Run("structured causes", func(t *testing.T) {
+		testLogger.EnableStructuredErrors(true)
+		t.Cleanup(func() { testLogger.EnableStructuredErrors(false) })
+
+		err := fmt.Errorf("failed to load config: %w", fs.ErrNotExist)
+		testLogger.WithError(err).Error("startup failed")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, []any{
+			map[string]any{"message": "file does not exist", "type": "*errors.errorString"},
+		}, o[logFieldErrorCausesStructured])
+		assert.NotContains(t, o, logFieldErrorCauses)
+
+		testLogger.Errorf("failed to load %s: %w", "config", fmt.Errorf("open file: %w", fs.ErrNotExist))
+
+		o = readTestEntry(t, &buf)
+		assert.Equal(t, "failed to load config: open file: file does not exist", o["msg"])
+		assert.Equal(t, "failed to load config: open file: file does not exist", o[logFieldError])
+		assert.Equal(t, []any{
+			map[string]any{"message": "open file: file does not exist", "type": "*fmt.wrapError"},
+			map[string]any{"message": "file does not exist", "type": "*errors.errorString"},
+		}, o[logFieldErrorCausesStructured])
+	})
+
+	t.Run("f-functions wrap only with a %w verb", func(t *testing.T) {
+		testLogger.EnableStructuredErrors(true)
+		t.Cleanup(func() { testLogger.EnableStructuredErrors(false) })
+
+		testLogger.Errorf("failed to load %[2]s: %[1]w", fs.ErrNotExist, "config")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "failed to load config: file does not exist", o["msg"])
+		assert.Equal(t, "failed to load config: file does not exist", o[logFieldError])
+
+		testLogger.Errorf("100%%w of %s", "config")
+
+		o = readTestEntry(t, &buf)
+		assert.Equal(t, "100%w of config", o["msg"])
+		assert.NotContains(t, o, logFieldError)
+
+		testLogger.EnableStructuredErrors(false)
+		testLogger.Errorf("failed to load config: %w", fs.ErrNotExist)
+
+		o = readTestEntry(t, &buf)
+		assert.Equal(t, "failed to load config: file does not exist", o["msg"])
+		assert.NotContains(t, o, logFieldError)
+	})
+
+	t.Run("helpers", func(t *testing.T) {
+		testLogger.LogSerdeError("json", SerdeOpDecode, fmt.Errorf("bad payload: %w", errors.New("unexpected EOF")), 10)
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "bad payload: unexpected EOF", o[logFieldError])
+		assert.Equal(t, "*fmt.wrapError", o[logFieldErrorType])
+		assert.Equal(t, []any{"unexpected EOF"}, o[logFieldErrorCauses])
+	})
+}
+
+func TestHasWrapVerb(t *testing.T) {
+	for format, want := range map[string]bool{
+		"failed: %w":       true,
+		"failed: %[1]w":    true,
+		"failed: %+w":      true,
+		"%s: %v":           false,
+		"100%%w":           false,
+		"100%%%w":          true,
+		"trailing %":       false,
+		"width %-10s, %3w": true,
+	} {
+		assert.Equal(t, want, hasWrapVerb(format), format)
+	}
+}
@@ -0,0 +1,40 @@
+//go:build !windows
+
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"errors"
+)
+
+// EventLogWriter writes log entries to the Windows Event Log. It is only
+// supported on Windows.
+type EventLogWriter struct{}
+
+// NewEventLogWriter returns an error as the Windows Event Log is only
+// supported on Windows.
+func NewEventLogWriter(_ string) (*EventLogWriter, error) {
+	return nil, errors.New("event log output is only supported on windows")
+}
+
+// Write is never called, as an EventLogWriter can't be created.
+func (w *EventLogWriter) Write(p []byte) (int, error) {
+	return 0, ErrWriterClosed
+}
+
+// Close is a no-op.
+func (w *EventLogWriter) Close() error {
+	return nil
+}
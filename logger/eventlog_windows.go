@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"golang.org/x/sys/windows/svc/eventlog"
+)
+
+// eventLogEventID is the ID of the reported events.
+const eventLogEventID = 1
+
+// EventLogWriter writes log entries to the Windows Event Log under a source
+// name: Error and Fatal entries as error events, Warn entries as warning
+// events and the others as information events.
+type EventLogWriter struct {
+	lock sync.Mutex
+	log  *eventlog.Log
+}
+
+// NewEventLogWriter returns an EventLogWriter reporting events under source.
+// The source should be registered, such as with eventlog.InstallAsEventCreate,
+// for the Event Viewer to render the messages.
+func NewEventLogWriter(source string) (*EventLogWriter, error) {
+	log, err := eventlog.Open(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the event log source %q: %w", source, err)
+	}
+
+	return &EventLogWriter{log: log}, nil
+}
+
+// Write reports p as an information event.
+func (w *EventLogWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(InfoLevel, p)
+}
+
+// WriteLevel reports p as an event of the type of lvl.
+func (w *EventLogWriter) WriteLevel(lvl LogLevel, p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.log == nil {
+		return 0, ErrWriterClosed
+	}
+
+	msg := string(bytes.TrimRight(p, "\r\n"))
+
+	var err error
+	switch lvl {
+	case ErrorLevel, FatalLevel, PanicLevel:
+		err = w.log.Error(eventLogEventID, msg)
+	case WarnLevel:
+		err = w.log.Warning(eventLogEventID, msg)
+	default:
+		err = w.log.Info(eventLogEventID, msg)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to report event: %w", err)
+	}
+
+	return len(p), nil
+}
+
+// Close closes the event log source.
+func (w *EventLogWriter) Close() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.log == nil {
+		return nil
+	}
+
+	err := w.log.Close()
+	w.log = nil
+
+	return err
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventLogWriter(t *testing.T) {
+	_, err := NewEventLogWriter("")
+	require.Error(t, err)
+
+	// Events of an unregistered source are reported to the Application log.
+	w, err := NewEventLogWriter("dapr-kit-test")
+	require.NoError(t, err)
+
+	for _, lvl := range []LogLevel{DebugLevel, InfoLevel, WarnLevel, ErrorLevel} {
+		n, err := w.WriteLevel(lvl, []byte("event\r\n"))
+		require.NoError(t, err)
+		assert.Equal(t, len("event\r\n"), n)
+	}
+
+	require.NoError(t, w.Close())
+
+	_, err = w.Write([]byte("dropped"))
+	require.ErrorIs(t, err, ErrWriterClosed)
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+const (
+	logFieldCacheName      = "cache_name"
+	logFieldEvictedKey     = "evicted_key"
+	logFieldEvictionReason = "eviction_reason"
+)
+
+// Cache eviction reasons accepted by LogEviction.
+const (
+	EvictionReasonSize   = "size"
+	EvictionReasonTTL    = "ttl"
+	EvictionReasonManual = "manual"
+)
+
+// LogEviction logs at level Debug that key was evicted from cacheName.
+// The reason must be one of EvictionReasonSize, EvictionReasonTTL or
+// EvictionReasonManual, otherwise the eviction is logged at level Warn.
+func (l *daprLogger) LogEviction(cacheName, key, reason string) {
+	log := l.WithFields(map[string]any{
+		logFieldCacheName:      cacheName,
+		logFieldEvictedKey:     key,
+		logFieldEvictionReason: reason,
+	})
+
+	switch reason {
+	case EvictionReasonSize, EvictionReasonTTL, EvictionReasonManual:
+		log.Debugf("Evicted %s from cache %s", key, cacheName)
+	default:
+		log.Warnf("Evicted %s from cache %s with unknown reason: %s", key, cacheName, reason)
+	}
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogEviction(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.SetOutputLevel(DebugLevel)
+
+	for _, reason := range []string{EvictionReasonSize, EvictionReasonTTL, EvictionReasonManual} {
+		t.Run(reason, func(t *testing.T) {
+			testLogger.LogEviction("actors", "actor||123", reason)
+
+			o := readTestEntry(t, &buf)
+			assert.Equal(t, "debug", o[logFieldLevel])
+			assert.Equal(t, "actors", o[logFieldCacheName])
+			assert.Equal(t, "actor||123", o[logFieldEvictedKey])
+			assert.Equal(t, reason, o[logFieldEvictionReason])
+		})
+	}
+
+	t.Run("unknown reason", func(t *testing.T) {
+		testLogger.LogEviction("actors", "actor||123", "cosmic-ray")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "warning", o[logFieldLevel])
+		assert.Equal(t, "cosmic-ray", o[logFieldEvictionReason])
+		assert.Contains(t, o[logFieldMessage], "unknown reason")
+	})
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// fatalFlushTimeout bounds the time spent exporting the pending entries
+// before the process exits on Fatal.
+const fatalFlushTimeout = 5 * time.Second
+
+var (
+	fatalHooksLock sync.Mutex
+	fatalHooks     []func()
+)
+
+// RegisterFatalHook registers fn to run before the process exits on Fatal and
+// Fatalf, for example to shut down a tracer provider. The hooks run in the
+// order they were registered, then the logs buffered with
+// Options.AsyncBufferSize and the entries pending export to
+// Options.OTLPEndpoint are flushed.
+func RegisterFatalHook(fn func()) {
+	fatalHooksLock.Lock()
+	defer fatalHooksLock.Unlock()
+
+	fatalHooks = append(fatalHooks, fn)
+}
+
+// runFatalHooks runs the fatal hooks and flushes the buffered logs.
+func runFatalHooks() {
+	fatalHooksLock.Lock()
+	hooks := fatalHooks
+	fatalHooksLock.Unlock()
+
+	for _, fn := range hooks {
+		fn()
+	}
+
+	Flush()
+
+	if exp := optionsExporter.Load(); exp != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), fatalFlushTimeout)
+		defer cancel()
+		exp.Flush(ctx)
+	}
+}
+
+// exit runs the fatal hooks then exits the process with status 1.
+func (l *daprLogger) exit() {
+	runFatalHooks()
+	l.logger.Logger.Exit(1)
+}
+
+// Panic logs a message at level Panic then panics with the message.
+func (l *daprLogger) Panic(args ...any) {
+	msg := fmt.Sprint(args...)
+	l.print(logrus.PanicLevel, msg)
+	Flush()
+	panic(msg)
+}
+
+// Panicf logs a message at level Panic then panics with the message.
+func (l *daprLogger) Panicf(format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	l.print(logrus.PanicLevel, msg)
+	Flush()
+	panic(msg)
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterFatalHook(t *testing.T) {
+	t.Cleanup(func() {
+		fatalHooksLock.Lock()
+		fatalHooks = nil
+		fatalHooksLock.Unlock()
+	})
+
+	var buf bytes.Buffer
+	testLogger := getTestLogger(&buf)
+
+	var calls []string
+	RegisterFatalHook(func() { calls = append(calls, "first") })
+	RegisterFatalHook(func() { calls = append(calls, "second") })
+	testLogger.logger.Logger.ExitFunc = func(code int) {
+		calls = append(calls, "exit")
+		assert.Equal(t, 1, code)
+	}
+
+	testLogger.Fatal("fatal error")
+	assert.Equal(t, []string{"first", "second", "exit"}, calls)
+	assert.Contains(t, buf.String(), "fatal error")
+
+	calls = nil
+	testLogger.Fatalf("fatal %s", "error")
+	assert.Equal(t, []string{"first", "second", "exit"}, calls)
+}
+
+func TestPanic(t *testing.T) {
+	var buf bytes.Buffer
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	assert.PanicsWithValue(t, "panic 1", func() {
+		testLogger.Panicf("panic %d", 1)
+	})
+
+	o := readTestEntry(t, &buf)
+	assert.Equal(t, "panic", o[logFieldLevel])
+	assert.Equal(t, "panic 1", o[logFieldMessage])
+
+	assert.PanicsWithValue(t, "panic", func() {
+		testLogger.Panic("panic")
+	})
+	assert.Equal(t, PanicLevel, toLogLevel("panic"))
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/sirupsen/logrus"
+)
+
+// FieldKeyCase is the case custom field keys are converted to.
+type FieldKeyCase int32
+
+const (
+	// CaseAsIs leaves field keys unchanged. This is the default.
+	CaseAsIs FieldKeyCase = iota
+	// CaseSnake converts field keys to snake_case.
+	CaseSnake
+	// CaseCamel converts field keys to camelCase.
+	CaseCamel
+)
+
+// standardFields are the field keys of the Dapr log schema, which are never
+// converted.
+var standardFields = map[string]struct{}{
+	logFieldTimeStamp:    {},
+	logFieldLevel:        {},
+	logFieldType:         {},
+	logFieldScope:        {},
+	logFieldMessage:      {},
+	logFieldInstance:     {},
+	logFieldDaprVer:      {},
+	logFieldAppID:        {},
+	logFieldComponentVer: {},
+	logFieldSchemaVer:    {},
+}
+
+// keyCaseExemptFields are the field keys the entries are routed on and of the
+// errors, which are never converted either.
+var keyCaseExemptFields = map[string]struct{}{
+	logFieldTenantID:    {},
+	logFieldChannel:     {},
+	logFieldSensitivity: {},
+	logFieldErrorType:   {},
+	logFieldErrorCauses: {},
+}
+
+// SetFieldKeyCase sets the case custom field keys are converted to before
+// encoding. The keys of the standard fields, of the fields the entries are
+// routed on, such as tenant_id, and of the error fields are left unchanged, as
+// are the keys which would convert to the key of another field, such as
+// myField next to my_field with CaseSnake.
+func (l *daprLogger) SetFieldKeyCase(c FieldKeyCase) {
+	l.core.fieldKeyCase.Store(int32(c))
+}
+
+// convertFieldKeys returns data with the custom field keys converted to c.
+// The keys already in case c take precedence, then the converted keys in
+// lexical order; the keys converting to a name already taken are kept as is,
+// so no value is lost whatever the map iteration order.
+func convertFieldKeys(data logrus.Fields, c FieldKeyCase) logrus.Fields {
+	converted := make(logrus.Fields, len(data))
+
+	var pending []string
+	for k, v := range data {
+		if isKeyCaseExempt(k) || convertFieldKey(k, c) == k {
+			converted[k] = v
+			continue
+		}
+		pending = append(pending, k)
+	}
+
+	slices.Sort(pending)
+	for _, k := range pending {
+		if ck := convertFieldKey(k, c); !hasKey(converted, ck) {
+			converted[ck] = data[k]
+		} else {
+			converted[k] = data[k]
+		}
+	}
+
+	return converted
+}
+
+func isKeyCaseExempt(k string) bool {
+	_, standard := standardFields[k]
+	_, exempt := keyCaseExemptFields[k]
+	return standard || exempt
+}
+
+// convertFieldKey returns k converted to c.
+func convertFieldKey(k string, c FieldKeyCase) string {
+	switch c {
+	case CaseSnake:
+		return toSnakeCase(k)
+	case CaseCamel:
+		return toCamelCase(k)
+	default:
+		return k
+	}
+}
+
+func hasKey(data logrus.Fields, k string) bool {
+	_, ok := data[k]
+	return ok
+}
+
+// toSnakeCase converts a camelCase, PascalCase or kebab-case key to snake_case.
+// Acronyms are kept together, so "HTTPStatus" becomes "http_status".
+func toSnakeCase(s string) string {
+	runes := []rune(s)
+
+	var b strings.Builder
+	b.Grow(len(s) + 4)
+	for i, r := range runes {
+		switch {
+		case r == '-' || r == ' ':
+			b.WriteByte('_')
+		case unicode.IsUpper(r):
+			if i > 0 && runes[i-1] != '_' && runes[i-1] != '-' &&
+				(!unicode.IsUpper(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// toCamelCase converts a snake_case or kebab-case key to camelCase.
+func toCamelCase(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	upper := false
+	for _, r := range s {
+		switch {
+		case r == '_' || r == '-' || r == ' ':
+			upper = b.Len() > 0
+		case upper:
+			b.WriteRune(unicode.ToUpper(r))
+			upper = false
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetFieldKeyCase(t *testing.T) {
+	logWithCase := func(t *testing.T, c FieldKeyCase, fields map[string]any) map[string]any {
+		t.Helper()
+
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+		testLogger.SetAppID("dapr_app")
+		testLogger.SetFieldKeyCase(c)
+
+		testLogger.WithFields(fields).Info("hello")
+
+		return readTestEntry(t, &buf)
+	}
+
+	t.Run("snake", func(t *testing.T) {
+		o := logWithCase(t, CaseSnake, map[string]any{"myField": 1})
+		assert.Contains(t, o, "my_field")
+		assert.NotContains(t, o, "myField")
+		assert.Equal(t, "dapr_app", o[logFieldAppID])
+	})
+
+	t.Run("camel", func(t *testing.T) {
+		o := logWithCase(t, CaseCamel, map[string]any{"my_field": 1})
+		assert.Contains(t, o, "myField")
+		assert.NotContains(t, o, "my_field")
+		// Standard fields are never converted.
+		assert.Equal(t, "dapr_app", o[logFieldAppID])
+		assert.NotContains(t, o, "appId")
+	})
+
+	t.Run("routing and error fields aren't converted", func(t *testing.T) {
+		o := logWithCase(t, CaseCamel, map[string]any{
+			logFieldTenantID:    "acme",
+			logFieldChannel:     "ops",
+			logFieldSensitivity: "restricted",
+			logFieldErrorType:   "*errors.errorString",
+			logFieldErrorCauses: []string{"cause"},
+		})
+		for _, k := range []string{logFieldTenantID, logFieldChannel, logFieldSensitivity, logFieldErrorType, logFieldErrorCauses} {
+			assert.Contains(t, o, k)
+		}
+	})
+
+	t.Run("colliding keys", func(t *testing.T) {
+		for range 20 {
+			o := logWithCase(t, CaseSnake, map[string]any{"my_field": 1, "myField": 2, "MyField": 3})
+			// The key already in snake case keeps its name, the others aren't
+			// converted.
+			assert.InDelta(t, 1, o["my_field"], 0)
+			assert.InDelta(t, 2, o["myField"], 0)
+			assert.InDelta(t, 3, o["MyField"], 0)
+
+			o = logWithCase(t, CaseSnake, map[string]any{"myField": 2, "MyField": 3})
+			// The first converted key in lexical order takes the name.
+			assert.InDelta(t, 3, o["my_field"], 0)
+			assert.InDelta(t, 2, o["myField"], 0)
+		}
+	})
+
+	t.Run("as is", func(t *testing.T) {
+		o := logWithCase(t, CaseAsIs, map[string]any{"myField": 1})
+		assert.Contains(t, o, "myField")
+	})
+}
+
+func TestToSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"myField":       "my_field",
+		"MyField":       "my_field",
+		"HTTPStatus":    "http_status",
+		"requestID":     "request_id",
+		"my-field":      "my_field",
+		"already_snake": "already_snake",
+		"a":             "a",
+	}
+	for in, want := range tests {
+		assert.Equalf(t, want, toSnakeCase(in), "input %s", in)
+	}
+}
+
+func TestToCamelCase(t *testing.T) {
+	tests := map[string]string{
+		"my_field":      "myField",
+		"my-field-name": "myFieldName",
+		"alreadyCamel":  "alreadyCamel",
+		"_leading":      "leading",
+	}
+	for in, want := range tests {
+		assert.Equalf(t, want, toCamelCase(in), "input %s", in)
+	}
+}
+
+func TestFieldKeyCaseTenantRouting(t *testing.T) {
+	var out, tenant bytes.Buffer
+	testLogger := getTestLogger(&out)
+	testLogger.SetFieldKeyCase(CaseCamel)
+	testLogger.SetTenantRouting(func(tenantID string) io.Writer {
+		if tenantID == "acme" {
+			return &tenant
+		}
+		return nil
+	})
+
+	testLogger.WithTenant("acme").Info("tenant entry")
+
+	assert.Contains(t, tenant.String(), "tenant entry")
+	assert.Empty(t, out.String())
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"fmt"
+	"path"
+	"slices"
+
+	"github.com/sirupsen/logrus"
+)
+
+// filterRoutingFields are the fields the entries are routed on, such as to
+// the audited sink or to a tenant sink, which are never filtered.
+var filterRoutingFields = []string{
+	logFieldSensitivity,
+	logFieldChannel,
+	logFieldTenantID,
+}
+
+// fieldFilter drops the fields of the entries, see SetFieldFilter.
+type fieldFilter struct {
+	allow []string
+	deny  []string
+}
+
+// SetFieldFilter drops from the entries, before they are formatted, the fields
+// matching a pattern of deny and, if allow isn't empty, the fields matching no
+// pattern of allow, for example to drop the payload dumps of every call site
+// at once. Patterns use the syntax of path.Match, such as "http.*". The
+// envelope fields, such as scope, the fields the entries are routed on, such
+// as sensitivity and tenant_id, and the fields added by the logger after the
+// filter, such as caller, are always kept. Empty lists disable the filter.
+func (l *daprLogger) SetFieldFilter(allow, deny []string) {
+	if len(allow) == 0 && len(deny) == 0 {
+		l.core.fieldFilter.Store(nil)
+		return
+	}
+
+	l.core.fieldFilter.Store(&fieldFilter{
+		allow: slices.Clone(allow),
+		deny:  slices.Clone(deny),
+	})
+}
+
+// filterFields drops the fields of entry filtered by f, if not nil.
+func (f *fieldFilter) filterFields(entry *logrus.Entry) {
+	if f == nil {
+		return
+	}
+
+	for k := range entry.Data {
+		if slices.Contains(sizeLimitExemptFields, k) || slices.Contains(filterRoutingFields, k) {
+			continue
+		}
+
+		if matchesAnyField(f.deny, k) || (len(f.allow) > 0 && !matchesAnyField(f.allow, k)) {
+			delete(entry.Data, k)
+		}
+	}
+}
+
+// matchesAnyField returns true if key matches one of patterns.
+func matchesAnyField(patterns []string, key string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, key); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateFieldPatterns returns an error if one of patterns is malformed.
+func validateFieldPatterns(patterns []string) error {
+	for _, p := range patterns {
+		if _, err := path.Match(p, ""); err != nil || p == "" {
+			return fmt.Errorf("invalid field pattern %q", p)
+		}
+	}
+
+	return nil
+}
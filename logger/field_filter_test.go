@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetFieldFilter(t *testing.T) {
+	var buf bytes.Buffer
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	fields := map[string]any{
+		"payload":     "dump",
+		"http.method": "GET",
+		"http.status": 200,
+		"request_id":  "abc",
+	}
+
+	t.Run("deny list", func(t *testing.T) {
+		testLogger.SetFieldFilter(nil, []string{"payload", "http.*"})
+		testLogger.WithFields(fields).Info("denied")
+
+		o := readTestEntry(t, &buf)
+		assert.NotContains(t, o, "payload")
+		assert.NotContains(t, o, "http.method")
+		assert.NotContains(t, o, "http.status")
+		assert.Equal(t, "abc", o["request_id"])
+		assert.Equal(t, fakeLoggerName, o[logFieldScope])
+	})
+
+	t.Run("allow list", func(t *testing.T) {
+		testLogger.SetFieldFilter([]string{"http.*", "payload"}, []string{"payload"})
+		testLogger.WithFields(fields).Info("allowed")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "GET", o["http.method"])
+		assert.InDelta(t, 200, o["http.status"], 0)
+		// The deny list takes precedence.
+		assert.NotContains(t, o, "payload")
+		assert.NotContains(t, o, "request_id")
+		// The envelope fields are kept.
+		assert.Equal(t, fakeLoggerName, o[logFieldScope])
+		assert.Equal(t, LogTypeLog, o[logFieldType])
+		assert.Equal(t, "allowed", o["msg"])
+	})
+
+	t.Run("routing fields are kept", func(t *testing.T) {
+		var audited, tenant, out bytes.Buffer
+		routed := getTestLogger(&out)
+		routed.SetAuditedSink(&audited)
+		routed.SetTenantRouting(func(tenantID string) io.Writer {
+			if tenantID == "acme" {
+				return &tenant
+			}
+			return nil
+		})
+		routed.SetFieldFilter([]string{"http.*"}, nil)
+
+		routed.WithSensitivity(SensitivityRestricted).WithFields(fields).Info("restricted")
+		assert.Contains(t, audited.String(), "restricted")
+		assert.NotContains(t, audited.String(), "request_id")
+
+		routed.WithTenant("acme").WithFields(fields).Info("tenant entry")
+		assert.Contains(t, tenant.String(), "tenant entry")
+		assert.NotContains(t, tenant.String(), "request_id")
+		assert.NotContains(t, out.String(), "tenant entry")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		testLogger.SetFieldFilter(nil, nil)
+		testLogger.WithFields(fields).Info("unfiltered")
+
+		o := readTestEntry(t, &buf)
+		for k := range fields {
+			assert.Contains(t, o, k)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+)
+
+// SetFloatPrecision sets the number of decimal places float field values are
+// rounded to before encoding. A negative value disables the rounding, which is
+// the default.
+func (l *daprLogger) SetFloatPrecision(digits int) {
+	if digits < 0 {
+		digits = -1
+	}
+	l.core.floatPrecision.Store(int32(digits)) //nolint:gosec
+}
+
+// roundFloatFields rounds the float values in data to digits decimal places.
+func roundFloatFields(data logrus.Fields, digits int) {
+	for k, v := range data {
+		switch f := v.(type) {
+		case float64:
+			data[k] = roundFloat(f, digits, 64)
+		case float32:
+			data[k] = float32(roundFloat(float64(f), digits, 32))
+		}
+	}
+}
+
+// roundFloat rounds f to digits decimal places, going through its decimal
+// representation so the result is the closest float to the rounded value.
+func roundFloat(f float64, digits int, bitSize int) float64 {
+	r, err := strconv.ParseFloat(strconv.FormatFloat(f, 'f', digits, bitSize), bitSize)
+	if err != nil {
+		// NaN and infinities are kept as they are.
+		return f
+	}
+
+	return r
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetFloatPrecision(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+
+	fields := map[string]any{
+		"ratio":   1.0 / 3,
+		"ratio32": float32(2.0 / 3),
+		"count":   7,
+	}
+
+	t.Run("json", func(t *testing.T) {
+		testLogger.EnableJSONOutput(true)
+		testLogger.SetFloatPrecision(2)
+		testLogger.WithFields(fields).Info("stats")
+
+		assert.Contains(t, buf.String(), `"ratio":0.33,`)
+		assert.Contains(t, buf.String(), `"ratio32":0.67,`)
+
+		o := readTestEntry(t, &buf)
+		buf.Reset()
+
+		assert.InDelta(t, float64(7), o["count"], 0)
+	})
+
+	t.Run("text", func(t *testing.T) {
+		testLogger.EnableJSONOutput(false)
+		testLogger.SetFloatPrecision(3)
+		testLogger.WithFields(fields).Info("stats")
+
+		assert.Contains(t, buf.String(), "ratio=0.333 ")
+		assert.Contains(t, buf.String(), "ratio32=0.667 ")
+		buf.Reset()
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		testLogger.EnableJSONOutput(true)
+		testLogger.SetFloatPrecision(-1)
+		testLogger.WithFields(fields).Info("stats")
+
+		assert.Contains(t, buf.String(), `"ratio":0.3333333333333333,`)
+	})
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"encoding/binary"
+)
+
+// FrameHeader is the header prepended to each formatted entry, for binary
+// protocols that need the length of the entries.
+type FrameHeader int32
+
+const (
+	// FrameNone writes the entries as they are. This is the default.
+	FrameNone FrameHeader = iota
+	// FrameVarint prepends the length of the entry as an unsigned varint.
+	FrameVarint
+	// FrameUint32BE prepends the length of the entry as a 4-byte big-endian
+	// unsigned integer.
+	FrameUint32BE
+)
+
+// SetFrameHeader sets the header prepended to each formatted entry.
+func (l *daprLogger) SetFrameHeader(h FrameHeader) {
+	l.core.frameHeader.Store(int32(h))
+}
+
+// frame returns the entry b with the header h prepended.
+func frame(h FrameHeader, b []byte) []byte {
+	var header []byte
+	switch h {
+	case FrameVarint:
+		header = binary.AppendUvarint(make([]byte, 0, binary.MaxVarintLen64+len(b)), uint64(len(b)))
+	case FrameUint32BE:
+		header = binary.BigEndian.AppendUint32(make([]byte, 0, 4+len(b)), uint32(len(b))) //nolint:gosec
+	default:
+		return b
+	}
+
+	return append(header, b...)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetFrameHeader(t *testing.T) {
+	messages := []string{"first", "second entry", "third"}
+
+	// logFramed logs the messages with header h and returns the stream.
+	logFramed := func(h FrameHeader) *bytes.Buffer {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+		testLogger.SetFrameHeader(h)
+
+		for _, msg := range messages {
+			testLogger.Info(msg)
+		}
+
+		return &buf
+	}
+
+	assertEntry := func(t *testing.T, b []byte, msg string) {
+		t.Helper()
+
+		var o map[string]any
+		require.NoError(t, json.Unmarshal(b, &o))
+		assert.Equal(t, msg, o[logFieldMessage])
+	}
+
+	t.Run("varint", func(t *testing.T) {
+		r := bufio.NewReader(logFramed(FrameVarint))
+
+		for _, msg := range messages {
+			n, err := binary.ReadUvarint(r)
+			require.NoError(t, err)
+
+			b := make([]byte, n)
+			_, err = io.ReadFull(r, b)
+			require.NoError(t, err)
+			assertEntry(t, b, msg)
+		}
+
+		_, err := r.ReadByte()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("uint32 big-endian", func(t *testing.T) {
+		r := logFramed(FrameUint32BE)
+
+		for _, msg := range messages {
+			var n uint32
+			require.NoError(t, binary.Read(r, binary.BigEndian, &n))
+
+			b := make([]byte, n)
+			_, err := io.ReadFull(r, b)
+			require.NoError(t, err)
+			assertEntry(t, b, msg)
+		}
+
+		assert.Zero(t, r.Len())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		r := logFramed(FrameNone)
+
+		for _, msg := range messages {
+			b, err := r.ReadBytes('\n')
+			require.NoError(t, err)
+			assertEntry(t, b, msg)
+		}
+	})
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// The special fields of Cloud Logging, see
+// https://cloud.google.com/logging/docs/structured-logging.
+const (
+	gcpFieldSeverity       = "severity"
+	gcpFieldMessage        = "message"
+	gcpFieldTime           = "time"
+	gcpFieldLabels         = "logging.googleapis.com/labels"
+	gcpFieldTrace          = "logging.googleapis.com/trace"
+	gcpFieldSpanID         = "logging.googleapis.com/spanId"
+	gcpFieldTraceSampled   = "logging.googleapis.com/trace_sampled"
+	gcpFieldSourceLocation = "logging.googleapis.com/sourceLocation"
+)
+
+// gcpProjectEnvVar is the environment variable with the Google Cloud project
+// ID, used to write the trace resource name.
+const gcpProjectEnvVar = "GOOGLE_CLOUD_PROJECT"
+
+// gcpLabelFields are the Dapr fields written as Cloud Logging labels.
+var gcpLabelFields = []string{logFieldScope, logFieldAppID, logFieldType, logFieldInstance, logFieldDaprVer}
+
+// gcpFormatter formats the entries as Cloud Logging structured JSON.
+type gcpFormatter struct {
+	timestampFormat string
+	// projectID is the Google Cloud project of the traces, if known
+	projectID string
+}
+
+func newGCPFormatter(timestampFormat string) *gcpFormatter {
+	return &gcpFormatter{
+		timestampFormat: timestampFormat,
+		projectID:       os.Getenv(gcpProjectEnvVar),
+	}
+}
+
+// Format implements logrus.Formatter.
+func (f *gcpFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	data := make(map[string]any, len(entry.Data)+4)
+	labels := make(map[string]string, len(gcpLabelFields))
+	for k, v := range entry.Data {
+		data[k] = jsonFieldValue(v)
+	}
+
+	for _, k := range gcpLabelFields {
+		if v, ok := data[k]; ok {
+			labels[k] = fmt.Sprint(v)
+			delete(data, k)
+		}
+	}
+	if len(labels) > 0 {
+		data[gcpFieldLabels] = labels
+	}
+
+	if traceID, ok := data[logFieldTraceID].(string); ok {
+		if f.projectID != "" {
+			traceID = "projects/" + f.projectID + "/traces/" + traceID
+		}
+		data[gcpFieldTrace] = traceID
+		delete(data, logFieldTraceID)
+	}
+	if spanID, ok := data[logFieldSpanID]; ok {
+		data[gcpFieldSpanID] = spanID
+		delete(data, logFieldSpanID)
+	}
+	if sampled, ok := data[logFieldTraceSampled]; ok {
+		data[gcpFieldTraceSampled] = sampled
+		delete(data, logFieldTraceSampled)
+	}
+
+	// The caller field is file:line.
+	if caller, ok := data[logFieldCaller].(string); ok {
+		file, line, _ := strings.Cut(caller, ":")
+		loc := map[string]any{"file": file}
+		if _, err := strconv.Atoi(line); err == nil {
+			// The line is a string in the LogEntrySourceLocation JSON.
+			loc["line"] = line
+		}
+		if fn, ok := data[logFieldFunc]; ok {
+			loc["function"] = fn
+			delete(data, logFieldFunc)
+		}
+		data[gcpFieldSourceLocation] = loc
+		delete(data, logFieldCaller)
+	}
+
+	data[gcpFieldTime] = entry.Time.Format(f.timestampFormat)
+	data[gcpFieldSeverity] = gcpSeverity(fromLogrusLevel(entry.Level))
+	data[gcpFieldMessage] = entry.Message
+
+	return marshalJSONEntry(data)
+}
+
+// gcpSeverity maps a LogLevel to a Cloud Logging severity.
+func gcpSeverity(lvl LogLevel) string {
+	switch lvl {
+	case TraceLevel, DebugLevel:
+		return "DEBUG"
+	case InfoLevel:
+		return "INFO"
+	case WarnLevel:
+		return "WARNING"
+	case ErrorLevel:
+		return "ERROR"
+	case FatalLevel:
+		return "CRITICAL"
+	case PanicLevel:
+		return "ALERT"
+	default:
+		return "DEFAULT"
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestGCPFormat(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	newTestLogger := func(buf *bytes.Buffer) *daprLogger {
+		testLogger := getTestLogger(buf)
+		testLogger.core.clock = clocktesting.NewFakeClock(now)
+		testLogger.EnableJSONOutput(true)
+		testLogger.SetJSONFormat(JSONFormatGCP)
+		testLogger.SetAppID("dapr-app")
+		return testLogger
+	}
+
+	t.Run("severity, message and labels", func(t *testing.T) {
+		var buf bytes.Buffer
+		testLogger := newTestLogger(&buf)
+
+		testLogger.WithFields(map[string]any{"component": "statestore"}).Warn("failed to save state")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "WARNING", o[gcpFieldSeverity])
+		assert.Equal(t, "failed to save state", o[gcpFieldMessage])
+		assert.Equal(t, "2026-03-01T12:30:00Z", o[gcpFieldTime])
+		assert.Equal(t, "statestore", o["component"])
+
+		labels, ok := o[gcpFieldLabels].(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, fakeLoggerName, labels[logFieldScope])
+		assert.Equal(t, "dapr-app", labels[logFieldAppID])
+		assert.NotContains(t, o, logFieldScope)
+	})
+
+	t.Run("trace correlation", func(t *testing.T) {
+		t.Setenv(gcpProjectEnvVar, "my-project")
+
+		var buf bytes.Buffer
+		testLogger := newTestLogger(&buf)
+
+		sc := testSpanContext(trace.FlagsSampled)
+		ctx := trace.ContextWithSpanContext(t.Context(), sc)
+		testLogger.WithContext(ctx).Info("traced")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "projects/my-project/traces/"+sc.TraceID().String(), o[gcpFieldTrace])
+		assert.Equal(t, sc.SpanID().String(), o[gcpFieldSpanID])
+		assert.Equal(t, true, o[gcpFieldTraceSampled])
+		assert.NotContains(t, o, logFieldTraceID)
+	})
+
+	t.Run("source location", func(t *testing.T) {
+		var buf bytes.Buffer
+		testLogger := newTestLogger(&buf)
+		testLogger.EnableCaller(true)
+
+		testLogger.Error("with caller")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "ERROR", o[gcpFieldSeverity])
+
+		loc, ok := o[gcpFieldSourceLocation].(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, "gcp_format_test.go", loc["file"])
+		assert.NotEmpty(t, loc["line"])
+		assert.Contains(t, loc["function"], "TestGCPFormat")
+	})
+}
+
+func TestGCPSeverity(t *testing.T) {
+	assert.Equal(t, "DEBUG", gcpSeverity(TraceLevel))
+	assert.Equal(t, "INFO", gcpSeverity(InfoLevel))
+	assert.Equal(t, "CRITICAL", gcpSeverity(FatalLevel))
+	assert.Equal(t, "DEFAULT", gcpSeverity(UndefinedLevel))
+}
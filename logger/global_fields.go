@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"maps"
+	"sync/atomic"
+
+	"github.com/sirupsen/logrus"
+)
+
+// globalFields are the fields added to the entries of all loggers.
+var globalFields atomic.Pointer[map[string]any]
+
+// SetGlobalFields sets the fields added to the entries of all loggers,
+// including the ones already created, such as the cluster, region or pod
+// namespace. The fields of an entry take precedence over the global fields
+// with the same key. It replaces the fields previously set; nil removes them.
+func SetGlobalFields(fields map[string]any) {
+	if len(fields) == 0 {
+		globalFields.Store(nil)
+		return
+	}
+
+	fields = maps.Clone(fields)
+	globalFields.Store(&fields)
+}
+
+// addGlobalFields adds the global fields to data, unless already set.
+func addGlobalFields(data logrus.Fields) {
+	fields := globalFields.Load()
+	if fields == nil {
+		return
+	}
+
+	for k, v := range *fields {
+		if _, ok := data[k]; !ok {
+			data[k] = v
+		}
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetGlobalFields(t *testing.T) {
+	t.Cleanup(func() { SetGlobalFields(nil) })
+
+	var buf bytes.Buffer
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	fields := map[string]any{"cluster": "prod-1", "region": "eu-west-1"}
+	SetGlobalFields(fields)
+	// The fields are copied.
+	fields["cluster"] = "changed"
+
+	o := loggedTestEntry(t, &buf, func() { testLogger.Info("global") })
+	assert.Equal(t, "prod-1", o["cluster"])
+	assert.Equal(t, "eu-west-1", o["region"])
+
+	o = loggedTestEntry(t, &buf, func() {
+		testLogger.WithFields(map[string]any{"region": "us-east-1"}).Info("overridden")
+	})
+	assert.Equal(t, "us-east-1", o["region"])
+
+	SetGlobalFields(nil)
+	o = loggedTestEntry(t, &buf, func() { testLogger.Info("removed") })
+	assert.NotContains(t, o, "cluster")
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	kclock "k8s.io/utils/clock"
+)
+
+var (
+	// globalRateLimiter caps the entries of all loggers, nil if not set.
+	globalRateLimiter atomic.Pointer[rateLimiter]
+	// globalRateLimitBypass lets Error and Fatal entries bypass the limit.
+	globalRateLimitBypass atomic.Bool
+	// globalDropped counts the entries dropped by the global rate limit.
+	globalDropped atomic.Uint64
+)
+
+// rateLimiter is a token bucket holding up to one second of tokens.
+type rateLimiter struct {
+	lock      sync.Mutex
+	clock     kclock.PassiveClock
+	perSecond float64
+	tokens    float64
+	last      time.Time
+}
+
+func newRateLimiter(perSecond int, clock kclock.PassiveClock) *rateLimiter {
+	return &rateLimiter{
+		clock:     clock,
+		perSecond: float64(perSecond),
+		tokens:    float64(perSecond),
+		last:      clock.Now(),
+	}
+}
+
+// allow takes a token, returning false if there is none left.
+func (r *rateLimiter) allow() bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := r.clock.Now()
+	if elapsed := now.Sub(r.last); elapsed > 0 {
+		r.tokens = min(r.perSecond, r.tokens+elapsed.Seconds()*r.perSecond)
+	}
+	r.last = now
+
+	if r.tokens < 1 {
+		return false
+	}
+	r.tokens--
+
+	return true
+}
+
+// SetGlobalRateLimit caps the number of entries per second written by all
+// loggers together, with bursts of up to one second of entries. Entries over
+// the cap are dropped and counted by GlobalDroppedTotal. A perSecond of zero
+// or less removes the cap.
+func SetGlobalRateLimit(perSecond int) {
+	if perSecond <= 0 {
+		globalRateLimiter.Store(nil)
+		return
+	}
+
+	globalRateLimiter.Store(newRateLimiter(perSecond, kclock.RealClock{}))
+}
+
+// SetGlobalRateLimitBypass sets whether Error and Fatal entries bypass the
+// global rate limit. They don't by default.
+func SetGlobalRateLimitBypass(enabled bool) {
+	globalRateLimitBypass.Store(enabled)
+}
+
+// GlobalDroppedTotal returns the number of entries dropped by the global rate
+// limit since the process started.
+func GlobalDroppedTotal() uint64 {
+	return globalDropped.Load()
+}
+
+// allowedByGlobalRateLimit returns true if an entry is within the global rate
+// limit. isError is true for the Error and Fatal entries.
+func allowedByGlobalRateLimit(isError bool) bool {
+	r := globalRateLimiter.Load()
+	if r == nil || (isError && globalRateLimitBypass.Load()) {
+		return true
+	}
+
+	if !r.allow() {
+		globalDropped.Add(1)
+		return false
+	}
+
+	return true
+}
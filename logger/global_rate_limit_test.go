@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestSetGlobalRateLimit(t *testing.T) {
+	t.Cleanup(func() {
+		SetGlobalRateLimit(0)
+		SetGlobalRateLimitBypass(false)
+	})
+
+	clock := clocktesting.NewFakePassiveClock(time.Now())
+
+	var buf1, buf2 bytes.Buffer
+
+	logger1 := getTestLogger(&buf1)
+	logger2 := newDaprLogger("otherLogger")
+	logger2.SetOutput(&buf2)
+
+	lines := func() int {
+		return strings.Count(buf1.String(), "\n") + strings.Count(buf2.String(), "\n")
+	}
+
+	// burst logs n entries with each logger.
+	burst := func(n int) {
+		for range n {
+			logger1.Info("burst")
+			logger2.Info("burst")
+		}
+	}
+
+	t.Run("shared cap", func(t *testing.T) {
+		SetGlobalRateLimit(10)
+		globalRateLimiter.Store(newRateLimiter(10, clock))
+		dropped := GlobalDroppedTotal()
+
+		burst(20)
+		assert.Equal(t, 10, lines())
+		assert.Equal(t, dropped+30, GlobalDroppedTotal())
+		assert.NotZero(t, buf1.Len())
+		assert.NotZero(t, buf2.Len())
+
+		clock.SetTime(clock.Now().Add(500 * time.Millisecond))
+		burst(20)
+		assert.Equal(t, 15, lines())
+	})
+
+	t.Run("errors bypass", func(t *testing.T) {
+		buf1.Reset()
+		buf2.Reset()
+
+		logger1.Error("dropped")
+		assert.Zero(t, lines())
+
+		SetGlobalRateLimitBypass(true)
+		logger1.Error("kept")
+		logger2.Info("dropped")
+		assert.Equal(t, 1, lines())
+	})
+
+	t.Run("no cap", func(t *testing.T) {
+		buf1.Reset()
+		buf2.Reset()
+
+		SetGlobalRateLimit(0)
+		burst(20)
+		assert.Equal(t, 40, lines())
+	})
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+const (
+	logFieldGRPCMethod   = "grpc_method"
+	logFieldStreamID     = "stream_id"
+	logFieldStreamEvent  = "stream_event"
+	logFieldMessageCount = "message_count"
+)
+
+// Stream events logged by LogStreamEvent.
+const (
+	StreamEventOpen    = "open"
+	StreamEventMessage = "message"
+	StreamEventClose   = "close"
+)
+
+// WithStream returns a logger with the grpc_method and stream_id fields of a
+// streaming RPC.
+func (l *daprLogger) WithStream(method string, streamID string) Logger {
+	return l.WithFields(map[string]any{
+		logFieldGRPCMethod: method,
+		logFieldStreamID:   streamID,
+	})
+}
+
+// LogStreamEvent logs a lifecycle event of a stream, usually on a logger
+// returned by WithStream, with the number of messages exchanged so far.
+// Open and close events are logged at level Info, others at level Debug.
+func (l *daprLogger) LogStreamEvent(event string, messageCount int) {
+	log := l.WithFields(map[string]any{
+		logFieldStreamEvent:  event,
+		logFieldMessageCount: messageCount,
+	})
+
+	switch event {
+	case StreamEventOpen, StreamEventClose:
+		log.Infof("Stream %s", event)
+	default:
+		log.Debugf("Stream %s", event)
+	}
+}
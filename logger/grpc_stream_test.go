@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamLogs(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.SetOutputLevel(InfoLevel)
+
+	streamLogger := testLogger.WithStream("/dapr.proto.runtime.v1.Dapr/SubscribeTopicEventsAlpha1", "stream-7")
+
+	streamLogger.LogStreamEvent(StreamEventOpen, 0)
+	streamLogger.LogStreamEvent(StreamEventMessage, 1)
+	streamLogger.LogStreamEvent(StreamEventClose, 12)
+
+	entries := readTestEntries(t, &buf)
+
+	// The message event is logged at level Debug.
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, StreamEventOpen, entries[0][logFieldStreamEvent])
+
+	closeEntry := entries[1]
+	assert.Equal(t, "info", closeEntry[logFieldLevel])
+	assert.Equal(t, "/dapr.proto.runtime.v1.Dapr/SubscribeTopicEventsAlpha1", closeEntry[logFieldGRPCMethod])
+	assert.Equal(t, "stream-7", closeEntry[logFieldStreamID])
+	assert.Equal(t, StreamEventClose, closeEntry[logFieldStreamEvent])
+	assert.InDelta(t, float64(12), closeEntry[logFieldMessageCount], 0)
+}
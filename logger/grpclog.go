@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/grpclog"
+)
+
+// grpcLogger is a grpclog.LoggerV2 writing the gRPC logs with a Logger.
+type grpcLogger struct {
+	log Logger
+}
+
+// NewGRPCLoggerV2 returns a grpclog.LoggerV2 writing the gRPC internal logs
+// with log, to be set with grpclog.SetLoggerV2. As gRPC is chatty at level
+// Info, about connection churn and name resolution, its Info logs are written
+// at level Debug, and its verbose logs, guarded by V(l) with l > 0, only when
+// the Trace level is output. Warnings, errors and fatal errors keep their
+// level.
+func NewGRPCLoggerV2(log Logger) grpclog.LoggerV2 {
+	return &grpcLogger{log: log}
+}
+
+func (g *grpcLogger) Info(args ...any) {
+	g.log.Debug(args...)
+}
+
+func (g *grpcLogger) Infoln(args ...any) {
+	g.log.Debug(sprintln(args...))
+}
+
+func (g *grpcLogger) Infof(format string, args ...any) {
+	g.log.Debugf(format, args...)
+}
+
+func (g *grpcLogger) Warning(args ...any) {
+	g.log.Warn(args...)
+}
+
+func (g *grpcLogger) Warningln(args ...any) {
+	g.log.Warn(sprintln(args...))
+}
+
+func (g *grpcLogger) Warningf(format string, args ...any) {
+	g.log.Warnf(format, args...)
+}
+
+func (g *grpcLogger) Error(args ...any) {
+	g.log.Error(args...)
+}
+
+func (g *grpcLogger) Errorln(args ...any) {
+	g.log.Error(sprintln(args...))
+}
+
+func (g *grpcLogger) Errorf(format string, args ...any) {
+	g.log.Errorf(format, args...)
+}
+
+func (g *grpcLogger) Fatal(args ...any) {
+	g.log.Fatal(args...)
+}
+
+func (g *grpcLogger) Fatalln(args ...any) {
+	g.log.Fatal(sprintln(args...))
+}
+
+func (g *grpcLogger) Fatalf(format string, args ...any) {
+	g.log.Fatalf(format, args...)
+}
+
+// V reports whether the verbosity level l is enabled: 0 if the Debug level is
+// output, and above 0 if the Trace level is.
+func (g *grpcLogger) V(l int) bool {
+	if l <= 0 {
+		return g.log.IsOutputLevelEnabled(DebugLevel)
+	}
+
+	return g.log.IsOutputLevelEnabled(TraceLevel)
+}
+
+// sprintln formats args like fmt.Sprintln, without the trailing newline.
+func sprintln(args ...any) string {
+	return strings.TrimSuffix(fmt.Sprintln(args...), "\n")
+}
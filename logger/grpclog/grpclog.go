@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package grpclog provides gRPC server interceptors logging one entry per call
+// with the request log type.
+package grpclog
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dapr/kit/logger"
+)
+
+const (
+	logFieldMethod     = "grpc_method"
+	logFieldCode       = "grpc_code"
+	logFieldDurationMs = "duration_ms"
+	logFieldPeer       = "peer"
+	logFieldPayload    = "payload"
+	// logFieldTruncated is the marker of the entries truncated by the size
+	// limits of the logger.
+	logFieldTruncated = "truncated"
+)
+
+// Option configures the interceptors.
+type Option func(o *options)
+
+type options struct {
+	skippedMethods  []string
+	payloads        bool
+	maxPayloadBytes int
+}
+
+// WithSkippedMethods excludes from logging the calls to methods, given as full
+// method names such as "/grpc.health.v1.Health/Check".
+func WithSkippedMethods(methods ...string) Option {
+	return func(o *options) {
+		o.skippedMethods = append(o.skippedMethods, methods...)
+	}
+}
+
+// WithPayloads logs at level Debug the messages received and sent, cut to
+// maxBytes if it's positive.
+func WithPayloads(maxBytes int) Option {
+	return func(o *options) {
+		o.payloads = true
+		o.maxPayloadBytes = maxBytes
+	}
+}
+
+// UnaryServerInterceptor returns an interceptor logging with log, with the
+// request log type, one entry per unary call once it is served, with the
+// method, status code, duration and peer address.
+func UnaryServerInterceptor(log logger.Logger, opts ...Option) grpc.UnaryServerInterceptor {
+	i := newInterceptor(log, opts)
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if i.isSkipped(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		i.logPayload(info.FullMethod, "request", req)
+		res, err := handler(ctx, req)
+		if err == nil {
+			i.logPayload(info.FullMethod, "response", res)
+		}
+		i.logCall(ctx, info.FullMethod, start, err)
+
+		return res, err
+	}
+}
+
+// StreamServerInterceptor returns an interceptor logging with log, with the
+// request log type, one entry per stream once it is closed, with the method,
+// status code, duration and peer address.
+func StreamServerInterceptor(log logger.Logger, opts ...Option) grpc.StreamServerInterceptor {
+	i := newInterceptor(log, opts)
+
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if i.isSkipped(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		start := time.Now()
+		if i.opts.payloads {
+			ss = &serverStream{ServerStream: ss, i: i, method: info.FullMethod}
+		}
+		err := handler(srv, ss)
+		i.logCall(ss.Context(), info.FullMethod, start, err)
+
+		return err
+	}
+}
+
+type interceptor struct {
+	log  logger.Logger
+	opts options
+}
+
+func newInterceptor(log logger.Logger, opts []Option) *interceptor {
+	i := &interceptor{log: log.WithLogType(logger.LogTypeRequest)}
+	for _, opt := range opts {
+		opt(&i.opts)
+	}
+
+	return i
+}
+
+func (i *interceptor) isSkipped(method string) bool {
+	return slices.Contains(i.opts.skippedMethods, method)
+}
+
+func (i *interceptor) logCall(ctx context.Context, method string, start time.Time, err error) {
+	code := status.Code(err)
+	fields := map[string]any{
+		logFieldMethod:     method,
+		logFieldCode:       code.String(),
+		logFieldDurationMs: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		fields[logFieldPeer] = p.Addr.String()
+	}
+
+	callLog := i.log.WithFields(fields)
+	msg := fmt.Sprintf("%s %s", method, code)
+	if isServerError(code) {
+		callLog.Error(msg)
+	} else {
+		callLog.Info(msg)
+	}
+}
+
+// logPayload logs msg at level Debug if the payloads are logged.
+func (i *interceptor) logPayload(method, kind string, msg any) {
+	if !i.opts.payloads || !i.log.IsOutputLevelEnabled(logger.DebugLevel) {
+		return
+	}
+
+	payload := marshalPayload(msg)
+	fields := map[string]any{logFieldMethod: method}
+	if n := i.opts.maxPayloadBytes; n > 0 && len(payload) > n {
+		// Don't cut a multi-byte character in half.
+		for n > 0 && !utf8.RuneStart(payload[n]) {
+			n--
+		}
+		payload = payload[:n]
+		fields[logFieldTruncated] = true
+	}
+	fields[logFieldPayload] = payload
+
+	i.log.WithFields(fields).Debug(method + " " + kind)
+}
+
+// marshalPayload returns the JSON encoding of the protobuf messages, and the
+// default format of the other values.
+func marshalPayload(msg any) string {
+	if m, ok := msg.(proto.Message); ok {
+		if b, err := protojson.Marshal(m); err == nil {
+			return string(b)
+		}
+	}
+
+	return fmt.Sprintf("%v", msg)
+}
+
+// isServerError returns true for the codes reporting a failure of the server
+// rather than of the client.
+func isServerError(code codes.Code) bool {
+	switch code {
+	case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented, codes.Internal, codes.Unavailable, codes.DataLoss:
+		return true
+	default:
+		return false
+	}
+}
+
+// serverStream logs the messages received and sent on a stream.
+type serverStream struct {
+	grpc.ServerStream
+	i      *interceptor
+	method string
+}
+
+func (s *serverStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.i.logPayload(s.method, "request", m)
+	}
+	return err
+}
+
+func (s *serverStream) SendMsg(m any) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.i.logPayload(s.method, "response", m)
+	}
+	return err
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpclog
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/kit/logger"
+	"github.com/dapr/kit/logger/loggertest"
+)
+
+func peerContext(t *testing.T) context.Context {
+	return peer.NewContext(t.Context(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IPv4(192, 0, 2, 1), Port: 1234},
+	})
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	log, rec := loggertest.New(t)
+
+	interceptor := UnaryServerInterceptor(log,
+		WithSkippedMethods("/grpc.health.v1.Health/Check"),
+		WithPayloads(8),
+	)
+
+	call := func(method string, err error) {
+		info := &grpc.UnaryServerInfo{FullMethod: method}
+		interceptor(peerContext(t), wrapperspb.String("hello world"), info, func(ctx context.Context, req any) (any, error) {
+			if err != nil {
+				return nil, err
+			}
+			return wrapperspb.String("ok"), nil
+		})
+	}
+
+	t.Run("one entry per call", func(t *testing.T) {
+		rec.Reset()
+		call("/dapr.Service/Get", nil)
+
+		var calls []logger.Record
+		for _, r := range rec.Records() {
+			assert.Equal(t, logger.LogTypeRequest, r.Type)
+			if r.Level == logger.InfoLevel {
+				calls = append(calls, r)
+			}
+		}
+		require.Len(t, calls, 1)
+
+		r := calls[0]
+		assert.Equal(t, "/dapr.Service/Get OK", r.Message)
+		assert.Equal(t, "/dapr.Service/Get", r.Fields[logFieldMethod])
+		assert.Equal(t, "OK", r.Fields[logFieldCode])
+		assert.Equal(t, "192.0.2.1:1234", r.Fields[logFieldPeer])
+		assert.Contains(t, r.Fields, logFieldDurationMs)
+	})
+
+	t.Run("payloads are logged at level debug with a size cap", func(t *testing.T) {
+		rec.Reset()
+		call("/dapr.Service/Get", nil)
+
+		assert.True(t, rec.FieldEquals(logger.DebugLevel, "/dapr.Service/Get request", logFieldPayload, `"hello w`))
+		assert.True(t, rec.FieldEquals(logger.DebugLevel, "/dapr.Service/Get request", logFieldTruncated, true))
+		assert.True(t, rec.FieldEquals(logger.DebugLevel, "/dapr.Service/Get response", logFieldPayload, `"ok"`))
+	})
+
+	t.Run("server errors are logged at level error", func(t *testing.T) {
+		rec.Reset()
+		call("/dapr.Service/Get", status.Error(codes.Internal, "boom"))
+
+		assert.True(t, rec.FieldEquals(logger.ErrorLevel, "/dapr.Service/Get Internal", logFieldCode, "Internal"))
+	})
+
+	t.Run("client errors are logged at level info", func(t *testing.T) {
+		rec.Reset()
+		call("/dapr.Service/Get", status.Error(codes.NotFound, "missing"))
+
+		assert.True(t, rec.FieldEquals(logger.InfoLevel, "/dapr.Service/Get NotFound", logFieldCode, "NotFound"))
+	})
+
+	t.Run("skipped methods aren't logged", func(t *testing.T) {
+		rec.Reset()
+		call("/grpc.health.v1.Health/Check", nil)
+
+		assert.Empty(t, rec.Records())
+	})
+}
+
+func TestLogPayload(t *testing.T) {
+	log, rec := loggertest.New(t)
+
+	i := newInterceptor(log, []Option{WithPayloads(8)})
+	i.logPayload("/dapr.Service/Get", "request", wrapperspb.String("abcdefé"))
+
+	// The payload is cut before é, which doesn't fit in the 8 bytes.
+	assert.True(t, rec.FieldEquals(logger.DebugLevel, "/dapr.Service/Get request", logFieldPayload, `"abcdef`))
+	assert.True(t, rec.FieldEquals(logger.DebugLevel, "/dapr.Service/Get request", logFieldTruncated, true))
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context { return s.ctx }
+
+func (s *fakeServerStream) RecvMsg(m any) error {
+	m.(*wrapperspb.StringValue).Value = "ping"
+	return nil
+}
+
+func (s *fakeServerStream) SendMsg(m any) error { return nil }
+
+func TestStreamServerInterceptor(t *testing.T) {
+	log, rec := loggertest.New(t)
+
+	interceptor := StreamServerInterceptor(log, WithPayloads(0))
+	info := &grpc.StreamServerInfo{FullMethod: "/dapr.Service/Watch"}
+
+	err := interceptor(nil, &fakeServerStream{ctx: peerContext(t)}, info, func(srv any, ss grpc.ServerStream) error {
+		var req wrapperspb.StringValue
+		require.NoError(t, ss.RecvMsg(&req))
+		require.NoError(t, ss.SendMsg(wrapperspb.String("pong")))
+		return status.Error(codes.Canceled, "done")
+	})
+	require.Error(t, err)
+
+	assert.True(t, rec.FieldEquals(logger.DebugLevel, "/dapr.Service/Watch request", logFieldPayload, `"ping"`))
+	assert.True(t, rec.FieldEquals(logger.DebugLevel, "/dapr.Service/Watch response", logFieldPayload, `"pong"`))
+	assert.True(t, rec.FieldEquals(logger.InfoLevel, "/dapr.Service/Watch Canceled", logFieldPeer, "192.0.2.1:1234"))
+}
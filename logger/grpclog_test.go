@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGRPCLoggerV2(t *testing.T) {
+	var buf bytes.Buffer
+	testLogger := getTestLogger(&buf)
+	grpcLog := NewGRPCLoggerV2(testLogger)
+
+	t.Run("info is written at level debug", func(t *testing.T) {
+		buf.Reset()
+		grpcLog.Infof("Subchannel Connectivity change to %s", "READY")
+		assert.Empty(t, buf.String())
+		assert.False(t, grpcLog.V(0))
+
+		testLogger.SetOutputLevel(DebugLevel)
+		defer testLogger.SetOutputLevel(InfoLevel)
+
+		assert.True(t, grpcLog.V(0))
+		assert.False(t, grpcLog.V(2))
+		grpcLog.Infoln("Subchannel", "READY")
+		assert.Contains(t, buf.String(), "level=debug")
+		assert.Contains(t, buf.String(), `msg="Subchannel READY"`)
+	})
+
+	t.Run("verbose logs are enabled at level trace", func(t *testing.T) {
+		testLogger.SetOutputLevel(TraceLevel)
+		defer testLogger.SetOutputLevel(InfoLevel)
+
+		assert.True(t, grpcLog.V(2))
+	})
+
+	t.Run("warnings and errors keep their level", func(t *testing.T) {
+		buf.Reset()
+		grpcLog.Warningln("resolver", "error")
+		assert.Contains(t, buf.String(), "level=warning")
+		assert.Contains(t, buf.String(), `msg="resolver error"`)
+
+		buf.Reset()
+		grpcLog.Errorf("failed to dial %s", "localhost")
+		assert.Contains(t, buf.String(), "level=error")
+	})
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// readTestEntry reads the next JSON entry written to buf.
+func readTestEntry(t *testing.T, buf *bytes.Buffer) map[string]any {
+	t.Helper()
+
+	b, err := buf.ReadBytes('\n')
+	require.NoError(t, err)
+
+	var o map[string]any
+	require.NoError(t, json.Unmarshal(b, &o))
+
+	return o
+}
+
+// readTestEntries reads all the JSON entries written to buf.
+func readTestEntries(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var entries []map[string]any
+	for buf.Len() > 0 {
+		entries = append(entries, readTestEntry(t, buf))
+	}
+
+	return entries
+}
+
+// loggedTestEntry returns the JSON entry written to buf by log.
+func loggedTestEntry(t *testing.T, buf *bytes.Buffer, log func()) map[string]any {
+	t.Helper()
+
+	buf.Reset()
+	log()
+
+	var o map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &o))
+
+	return o
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"slices"
+)
+
+// Record is the log entry passed to the hooks added with AddHook. It doesn't
+// depend on the underlying logging library, so it's stable across changes of
+// it.
+type Record = Entry
+
+// AddHook registers fn to be invoked with each entry written at one of levels,
+// or at any level if levels is empty. Like the OnWrite callbacks, hooks are
+// invoked after the entry is written and outside of the logger lock, so they
+// may log, and the Fields of the record may be modified.
+func (l *daprLogger) AddHook(levels []LogLevel, fn func(Record)) {
+	levels = slices.Clone(levels)
+
+	l.OnWrite(func(e Entry, _ int, _ error) {
+		if len(levels) == 0 || slices.Contains(levels, e.Level) {
+			fn(e)
+		}
+	})
+}
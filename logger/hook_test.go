@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddHook(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.SetOutputLevel(DebugLevel)
+
+	var errs, all []Record
+	testLogger.AddHook([]LogLevel{ErrorLevel, FatalLevel}, func(r Record) {
+		errs = append(errs, r)
+	})
+	testLogger.AddHook(nil, func(r Record) {
+		all = append(all, r)
+	})
+
+	testLogger.Debug("debugging")
+	testLogger.WithFields(map[string]any{"component": "statestore"}).Error("failed to connect")
+	testLogger.Info("connected")
+
+	require.Len(t, errs, 1)
+	assert.Equal(t, ErrorLevel, errs[0].Level)
+	assert.Equal(t, fakeLoggerName, errs[0].Scope)
+	assert.Equal(t, "failed to connect", errs[0].Message)
+	assert.Equal(t, "statestore", errs[0].Fields["component"])
+	assert.False(t, errs[0].Time.IsZero())
+
+	require.Len(t, all, 3)
+	assert.Equal(t, []string{"debugging", "failed to connect", "connected"},
+		[]string{all[0].Message, all[1].Message, all[2].Message})
+
+	t.Run("hooks may log", func(t *testing.T) {
+		var mirrored int
+		alerts := getTestLogger(&buf)
+		testLogger.AddHook([]LogLevel{WarnLevel}, func(r Record) {
+			mirrored++
+			alerts.Info("alert: " + r.Message)
+		})
+
+		testLogger.Warn("disk almost full")
+
+		assert.Equal(t, 1, mirrored)
+		assert.Contains(t, buf.String(), "alert: disk almost full")
+	})
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package httplog provides an HTTP middleware logging one entry per request
+// with the request log type.
+package httplog
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/dapr/kit/logger"
+)
+
+const (
+	logFieldMethod     = "method"
+	logFieldPath       = "path"
+	logFieldStatus     = "status"
+	logFieldDurationMs = "duration_ms"
+	logFieldBytes      = "bytes"
+	logFieldRemoteAddr = "remote_addr"
+	logFieldUserAgent  = "user_agent"
+)
+
+// Option configures the middleware returned by Middleware.
+type Option func(o *options)
+
+type options struct {
+	excludedPaths []string
+}
+
+// WithExcludedPaths excludes from logging the requests whose path matches one
+// of patterns, with the syntax of path.Match, such as the health probes:
+//
+//	httplog.WithExcludedPaths("/healthz", "/v1.0/healthz/*")
+func WithExcludedPaths(patterns ...string) Option {
+	return func(o *options) {
+		o.excludedPaths = append(o.excludedPaths, patterns...)
+	}
+}
+
+// Middleware returns a middleware logging with log, with the request log type,
+// one entry per request once it is served, with the method, path, status,
+// duration, bytes written, remote address and user agent. The requests
+// answered with a 5xx status, or whose handler panics, are logged at level
+// Error, the others at level Info. The handlers can still flush, hijack and
+// ReadFrom the response writer.
+func Middleware(log logger.Logger, opts ...Option) func(http.Handler) http.Handler {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	log = log.WithLogType(logger.LogTypeRequest)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if o.isExcluded(r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			start := time.Now()
+			rw := &responseWriter{ResponseWriter: w, status: http.StatusOK}
+
+			// Log from a defer, so the requests whose handler panics are
+			// logged too, before the panic goes on to the server.
+			defer func() {
+				p := recover()
+				if p != nil && !rw.wroteHeader {
+					rw.status = http.StatusInternalServerError
+				}
+				logRequest(log, r, rw, start)
+				if p != nil {
+					panic(p)
+				}
+			}()
+
+			next.ServeHTTP(wrapResponseWriter(rw), r)
+		})
+	}
+}
+
+// logRequest logs the entry of the request r served with rw.
+func logRequest(log logger.Logger, r *http.Request, rw *responseWriter, start time.Time) {
+	reqLog := log.WithFields(map[string]any{
+		logFieldMethod:     r.Method,
+		logFieldPath:       r.URL.Path,
+		logFieldStatus:     rw.status,
+		logFieldDurationMs: float64(time.Since(start).Microseconds()) / 1000,
+		logFieldBytes:      rw.bytes,
+		logFieldRemoteAddr: r.RemoteAddr,
+		logFieldUserAgent:  r.UserAgent(),
+	})
+
+	msg := fmt.Sprintf("%s %s %d", r.Method, r.URL.Path, rw.status)
+	if rw.status >= http.StatusInternalServerError {
+		reqLog.Error(msg)
+	} else {
+		reqLog.Info(msg)
+	}
+}
+
+func (o *options) isExcluded(p string) bool {
+	for _, pattern := range o.excludedPaths {
+		if ok, _ := path.Match(pattern, p); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// responseWriter records the status and the number of bytes of the response.
+type responseWriter struct {
+	http.ResponseWriter
+	status      int
+	bytes       int
+	wroteHeader bool
+}
+
+func (w *responseWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status = status
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *responseWriter) Write(p []byte) (int, error) {
+	w.wroteHeader = true
+	n, err := w.ResponseWriter.Write(p)
+	w.bytes += n
+	return n, err
+}
+
+// Flush implements http.Flusher, flushing the underlying writer if it can.
+func (w *responseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		w.wroteHeader = true
+		f.Flush()
+	}
+}
+
+// ReadFrom implements io.ReaderFrom, with the io.ReaderFrom of the underlying
+// writer if it has one, such as for sendfile.
+func (w *responseWriter) ReadFrom(r io.Reader) (int64, error) {
+	w.wroteHeader = true
+
+	var (
+		n   int64
+		err error
+	)
+	if rf, ok := w.ResponseWriter.(io.ReaderFrom); ok {
+		n, err = rf.ReadFrom(r)
+	} else {
+		n, err = io.Copy(w.ResponseWriter, r)
+	}
+	w.bytes += int(n)
+
+	return n, err
+}
+
+// Unwrap returns the underlying writer, for http.ResponseController.
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// hijackResponseWriter is a responseWriter whose underlying writer is an
+// http.Hijacker, such as for the WebSocket upgrades.
+type hijackResponseWriter struct {
+	*responseWriter
+}
+
+// Hijack implements http.Hijacker. The request is logged with the 101 status
+// if no header was written.
+func (w hijackResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, buf, err := w.ResponseWriter.(http.Hijacker).Hijack()
+	if err == nil && !w.wroteHeader {
+		w.status = http.StatusSwitchingProtocols
+		w.wroteHeader = true
+	}
+
+	return conn, buf, err
+}
+
+// wrapResponseWriter returns rw, as an http.Hijacker if its underlying
+// writer is one.
+func wrapResponseWriter(rw *responseWriter) http.ResponseWriter {
+	if _, ok := rw.ResponseWriter.(http.Hijacker); ok {
+		return hijackResponseWriter{rw}
+	}
+
+	return rw
+}
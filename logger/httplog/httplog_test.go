@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httplog
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/kit/logger"
+	"github.com/dapr/kit/logger/loggertest"
+)
+
+func TestMiddleware(t *testing.T) {
+	log, rec := loggertest.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1.0/state", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello"))
+	})
+	mux.HandleFunc("/v1.0/fail", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "boom", http.StatusInternalServerError)
+	})
+	mux.HandleFunc("/v1.0/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/v1.0/stream", func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(w, strings.NewReader("hello"))
+		w.(http.Flusher).Flush()
+	})
+	mux.HandleFunc("/v1.0/panic", func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	})
+	mux.HandleFunc("/v1.0/upgrade", func(w http.ResponseWriter, r *http.Request) {
+		conn, buf, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		buf.WriteString("HTTP/1.1 101 Switching Protocols\r\n\r\n")
+		buf.Flush()
+	})
+
+	handler := Middleware(log, WithExcludedPaths("/v1.0/healthz"))(mux)
+
+	serve := func(method, target string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, nil)
+		req.Header.Set("User-Agent", "test-agent")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("one entry per request", func(t *testing.T) {
+		rec.Reset()
+		serve(http.MethodGet, "/v1.0/state?key=1")
+
+		records := rec.Records()
+		require.Len(t, records, 1)
+
+		r := records[0]
+		assert.Equal(t, logger.InfoLevel, r.Level)
+		assert.Equal(t, logger.LogTypeRequest, r.Type)
+		assert.Equal(t, "GET /v1.0/state 200", r.Message)
+		assert.Equal(t, http.MethodGet, r.Fields[logFieldMethod])
+		assert.Equal(t, "/v1.0/state", r.Fields[logFieldPath])
+		assert.Equal(t, http.StatusOK, r.Fields[logFieldStatus])
+		assert.Equal(t, 5, r.Fields[logFieldBytes])
+		assert.Equal(t, "test-agent", r.Fields[logFieldUserAgent])
+		assert.Equal(t, "192.0.2.1:1234", r.Fields[logFieldRemoteAddr])
+		assert.Contains(t, r.Fields, logFieldDurationMs)
+	})
+
+	t.Run("server errors are logged at level error", func(t *testing.T) {
+		rec.Reset()
+		serve(http.MethodPost, "/v1.0/fail")
+
+		assert.True(t, rec.FieldEquals(logger.ErrorLevel, "POST /v1.0/fail", logFieldStatus, http.StatusInternalServerError))
+	})
+
+	t.Run("excluded paths aren't logged", func(t *testing.T) {
+		rec.Reset()
+		serve(http.MethodGet, "/v1.0/healthz")
+
+		assert.Empty(t, rec.Records())
+	})
+
+	t.Run("flusher and reader from are passed through", func(t *testing.T) {
+		rec.Reset()
+		w := serve(http.MethodGet, "/v1.0/stream")
+
+		assert.True(t, w.Flushed)
+		assert.Equal(t, "hello", w.Body.String())
+		assert.True(t, rec.FieldEquals(logger.InfoLevel, "GET /v1.0/stream 200", logFieldBytes, 5))
+	})
+
+	t.Run("panicking handlers are logged", func(t *testing.T) {
+		rec.Reset()
+		assert.PanicsWithValue(t, "boom", func() { serve(http.MethodGet, "/v1.0/panic") })
+
+		assert.True(t, rec.FieldEquals(logger.ErrorLevel, "GET /v1.0/panic 500", logFieldStatus, http.StatusInternalServerError))
+	})
+
+	t.Run("hijacker is passed through", func(t *testing.T) {
+		rec.Reset()
+		srv := httptest.NewServer(handler)
+		defer srv.Close()
+
+		res, err := http.Get(srv.URL + "/v1.0/upgrade")
+		require.NoError(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusSwitchingProtocols, res.StatusCode)
+
+		assert.Eventually(t, func() bool {
+			return rec.FieldEquals(logger.InfoLevel, "GET /v1.0/upgrade 101", logFieldStatus, http.StatusSwitchingProtocols)
+		}, time.Second, 10*time.Millisecond)
+	})
+}
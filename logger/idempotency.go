@@ -0,0 +1,32 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"time"
+)
+
+const (
+	logFieldIdempotencyKey = "idempotency_key"
+	logFieldFirstSeen      = "first_seen"
+)
+
+// LogIdempotencyCollision logs at level Warn that a request reused the
+// idempotency key first seen at firstSeen.
+func (l *daprLogger) LogIdempotencyCollision(key string, firstSeen time.Time) {
+	l.WithFields(map[string]any{
+		logFieldIdempotencyKey: key,
+		logFieldFirstSeen:      firstSeen.Format(time.RFC3339Nano),
+	}).Warnf("Idempotency key %s collides with a request first seen at %s", key, firstSeen.Format(time.RFC3339Nano))
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogIdempotencyCollision(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	firstSeen := time.Date(2026, 3, 1, 12, 0, 0, 500, time.UTC)
+	testLogger.LogIdempotencyCollision("order-42", firstSeen)
+
+	o := readTestEntry(t, &buf)
+
+	assert.Equal(t, "warning", o[logFieldLevel])
+	assert.Equal(t, "order-42", o[logFieldIdempotencyKey])
+	assert.Equal(t, "2026-03-01T12:00:00.0000005Z", o[logFieldFirstSeen])
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+const (
+	logFieldOperation = "operation"
+	logFieldSuccess   = "success"
+)
+
+// Instrument logs at level Info that the operation name started, runs fn, and
+// logs its completion with the duration_ms and success fields. Failures are
+// logged at level Error with the error. It returns the error returned by fn.
+func (l *daprLogger) Instrument(name string, fn func() error) error {
+	opLogger := l.WithFields(map[string]any{
+		logFieldOperation: name,
+	})
+
+	opLogger.Infof("Starting %s", name)
+
+	start := l.core.clock.Now()
+	err := fn()
+	fields := map[string]any{
+		logFieldDurationMs: toMilliseconds(l.core.clock.Since(start)),
+		logFieldSuccess:    err == nil,
+	}
+
+	if err != nil {
+		opLogger.WithError(err).WithFields(fields).Errorf("Failed %s", name)
+		return err
+	}
+
+	opLogger.WithFields(fields).Infof("Completed %s", name)
+
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestInstrument(t *testing.T) {
+	var buf bytes.Buffer
+
+	clock := clocktesting.NewFakeClock(time.Now())
+	testLogger := getTestLogger(&buf)
+	testLogger.core.clock = clock
+	testLogger.EnableJSONOutput(true)
+
+	t.Run("success", func(t *testing.T) {
+		err := testLogger.Instrument("load-components", func() error {
+			clock.Step(40 * time.Millisecond)
+			return nil
+		})
+		require.NoError(t, err)
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "Starting load-components", o[logFieldMessage])
+		assert.Equal(t, "load-components", o[logFieldOperation])
+		assert.NotContains(t, o, logFieldDurationMs)
+
+		o = readTestEntry(t, &buf)
+		assert.Equal(t, "info", o[logFieldLevel])
+		assert.Equal(t, "Completed load-components", o[logFieldMessage])
+		assert.Equal(t, true, o[logFieldSuccess])
+		assert.InDelta(t, float64(40), o[logFieldDurationMs], 0.001)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		fnErr := errors.New("component not found")
+		err := testLogger.Instrument("load-components", func() error {
+			return fnErr
+		})
+		require.ErrorIs(t, err, fnErr)
+
+		readTestEntry(t, &buf)
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "error", o[logFieldLevel])
+		assert.Equal(t, false, o[logFieldSuccess])
+		assert.Equal(t, "component not found", o[logFieldError])
+	})
+}
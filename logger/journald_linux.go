@@ -0,0 +1,260 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+// DefaultJournaldSocket is the socket of the systemd journal.
+const DefaultJournaldSocket = "/run/systemd/journal/socket"
+
+// Journal fields the standard fields of the entries are written to.
+const (
+	journaldFieldMessage    = "MESSAGE"
+	journaldFieldPriority   = "PRIORITY"
+	journaldFieldIdentifier = "SYSLOG_IDENTIFIER"
+)
+
+// journaldReservedFields are the journal fields with a meaning to the journal,
+// see systemd.journal-fields(7). The entry fields with these names are
+// prefixed, so a message or priority field doesn't replace the MESSAGE and
+// PRIORITY of the entry.
+var journaldReservedFields = map[string]struct{}{
+	journaldFieldMessage:    {},
+	journaldFieldPriority:   {},
+	journaldFieldIdentifier: {},
+	"MESSAGE_ID":            {},
+	"CODE_FILE":             {},
+	"CODE_LINE":             {},
+	"CODE_FUNC":             {},
+	"ERRNO":                 {},
+	"INVOCATION_ID":         {},
+	"USER_INVOCATION_ID":    {},
+	"SYSLOG_FACILITY":       {},
+	"SYSLOG_PID":            {},
+	"SYSLOG_TIMESTAMP":      {},
+	"SYSLOG_RAW":            {},
+	"DOCUMENTATION":         {},
+	"TID":                   {},
+	"UNIT":                  {},
+	"USER_UNIT":             {},
+}
+
+// JournaldWriter writes log entries to the systemd journal, with the message
+// in MESSAGE, the priority of the entry level in PRIORITY and each field as a
+// journal field, such as scope in SCOPE and app_id in APP_ID.
+type JournaldWriter struct {
+	lock       sync.Mutex
+	conn       *net.UnixConn
+	identifier string
+}
+
+// NewJournaldWriter returns a JournaldWriter connected to the systemd journal.
+func NewJournaldWriter() (*JournaldWriter, error) {
+	return newJournaldWriter(DefaultJournaldSocket)
+}
+
+func newJournaldWriter(socket string) (*JournaldWriter, error) {
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: socket, Net: "unixgram"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the journal at %s: %w", socket, err)
+	}
+
+	return &JournaldWriter{
+		conn:       conn,
+		identifier: filepath.Base(os.Args[0]),
+	}, nil
+}
+
+// Write sends p as the message of a journal entry with the priority of
+// level Info.
+func (w *JournaldWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(InfoLevel, p)
+}
+
+// WriteLevel sends p as the message of a journal entry with the priority of
+// lvl.
+func (w *JournaldWriter) WriteLevel(lvl LogLevel, p []byte) (int, error) {
+	var buf bytes.Buffer
+	w.writeHeader(&buf, lvl, string(bytes.TrimRight(p, "\n")))
+
+	if err := w.send(buf.Bytes()); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// WriteEntry sends e as a journal entry, with its fields as journal fields.
+// p is only used for the returned length.
+func (w *JournaldWriter) WriteEntry(e Entry, p []byte) (int, error) {
+	var buf bytes.Buffer
+	w.writeHeader(&buf, e.Level, e.Message)
+
+	keys := make([]string, 0, len(e.Fields))
+	for k := range e.Fields {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		writeJournaldField(&buf, journaldFieldName(k), journaldValue(e.Fields[k]))
+	}
+
+	if err := w.send(buf.Bytes()); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+func (w *JournaldWriter) writeHeader(buf *bytes.Buffer, lvl LogLevel, msg string) {
+	writeJournaldField(buf, journaldFieldMessage, msg)
+	writeJournaldField(buf, journaldFieldPriority, fmt.Sprint(syslogSeverity(lvl)))
+	writeJournaldField(buf, journaldFieldIdentifier, w.identifier)
+}
+
+// send writes msg to the journal in a datagram or, if it's too large, in a
+// temporary file passed as a file descriptor.
+func (w *JournaldWriter) send(msg []byte) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.conn == nil {
+		return ErrWriterClosed
+	}
+
+	_, err := w.conn.Write(msg)
+	if err == nil {
+		return nil
+	}
+
+	var errno syscall.Errno
+	if !errors.As(err, &errno) || (errno != syscall.EMSGSIZE && errno != syscall.ENOBUFS) {
+		return fmt.Errorf("failed to write to the journal: %w", err)
+	}
+
+	f, err := os.CreateTemp("/dev/shm", "dapr-journal-")
+	if err != nil {
+		return fmt.Errorf("failed to write to the journal: %w", err)
+	}
+	defer f.Close()
+
+	// The journal only reads the file through the descriptor.
+	if err = os.Remove(f.Name()); err != nil {
+		return fmt.Errorf("failed to write to the journal: %w", err)
+	}
+	if _, err = f.Write(msg); err != nil {
+		return fmt.Errorf("failed to write to the journal: %w", err)
+	}
+
+	rc, err := w.conn.SyscallConn()
+	if err != nil {
+		return fmt.Errorf("failed to write to the journal: %w", err)
+	}
+
+	var serr error
+	err = rc.Write(func(fd uintptr) bool {
+		serr = syscall.Sendmsg(int(fd), nil, syscall.UnixRights(int(f.Fd())), nil, 0)
+		return !errors.Is(serr, syscall.EAGAIN)
+	})
+	if err = errors.Join(err, serr); err != nil {
+		return fmt.Errorf("failed to write to the journal: %w", err)
+	}
+
+	return nil
+}
+
+// Close closes the connection to the journal.
+func (w *JournaldWriter) Close() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.conn == nil {
+		return nil
+	}
+
+	err := w.conn.Close()
+	w.conn = nil
+
+	return err
+}
+
+// writeJournaldField writes the field in the journal native protocol: as
+// NAME=value, or with the value length in binary if it spans several lines.
+func writeJournaldField(buf *bytes.Buffer, name, value string) {
+	buf.WriteString(name)
+	if !strings.Contains(value, "\n") {
+		buf.WriteByte('=')
+		buf.WriteString(value)
+		buf.WriteByte('\n')
+		return
+	}
+
+	buf.WriteByte('\n')
+	_ = binary.Write(buf, binary.LittleEndian, uint64(len(value)))
+	buf.WriteString(value)
+	buf.WriteByte('\n')
+}
+
+// journaldFieldName returns key as a journal field name, which contains only
+// uppercase letters, digits and underscores, and can't start with an
+// underscore or a digit. The names of journaldReservedFields are prefixed
+// with F_ like the invalid ones.
+func journaldFieldName(key string) string {
+	name := []byte(strings.ToUpper(key))
+	for i, c := range name {
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			name[i] = '_'
+		}
+	}
+
+	if len(name) == 0 || name[0] == '_' || (name[0] >= '0' && name[0] <= '9') {
+		return "F_" + string(name)
+	}
+	if _, ok := journaldReservedFields[string(name)]; ok {
+		return "F_" + string(name)
+	}
+
+	return string(name)
+}
+
+func journaldValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case error:
+		return v.Error()
+	case fmt.Stringer:
+		return v.String()
+	}
+
+	if b, err := json.Marshal(v); err == nil {
+		return string(b)
+	}
+
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestJournal(t *testing.T) (*net.UnixConn, string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "journal.sock")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: path, Net: "unixgram"})
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return conn, path
+}
+
+// readJournalEntry reads an entry sent in the journal native protocol, in a
+// datagram or in a passed file descriptor.
+func readJournalEntry(t *testing.T, conn *net.UnixConn) map[string]string {
+	t.Helper()
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+
+	b := make([]byte, 64*1024)
+	oob := make([]byte, syscall.CmsgSpace(4))
+	n, oobn, _, _, err := conn.ReadMsgUnix(b, oob)
+	require.NoError(t, err)
+	b = b[:n]
+
+	if oobn > 0 {
+		msgs, err := syscall.ParseSocketControlMessage(oob[:oobn])
+		require.NoError(t, err)
+		fds, err := syscall.ParseUnixRights(&msgs[0])
+		require.NoError(t, err)
+
+		f := os.NewFile(uintptr(fds[0]), "journal")
+		defer f.Close()
+		_, err = f.Seek(0, io.SeekStart)
+		require.NoError(t, err)
+		b, err = io.ReadAll(f)
+		require.NoError(t, err)
+	}
+
+	fields := make(map[string]string)
+	for len(b) > 0 {
+		i := bytes.IndexAny(b, "=\n")
+		require.GreaterOrEqual(t, i, 0)
+
+		name := string(b[:i])
+		if b[i] == '=' {
+			end := bytes.IndexByte(b, '\n')
+			fields[name] = string(b[i+1 : end])
+			b = b[end+1:]
+			continue
+		}
+
+		size := binary.LittleEndian.Uint64(b[i+1 : i+9])
+		fields[name] = string(b[i+9 : i+9+int(size)])
+		b = b[i+9+int(size)+1:]
+	}
+
+	return fields
+}
+
+func TestJournaldWriter(t *testing.T) {
+	journal, path := newTestJournal(t)
+
+	w, err := newJournaldWriter(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { w.Close() })
+
+	var buf bytes.Buffer
+	testLogger := getTestLogger(&buf)
+	testLogger.SetAppID("myapp")
+	testLogger.SetOutput(w)
+
+	t.Run("fields are journal fields", func(t *testing.T) {
+		testLogger.WithFields(map[string]any{
+			"request-id": "abc",
+			"count":      3,
+			"_internal":  true,
+		}).Warn("disk almost full")
+
+		fields := readJournalEntry(t, journal)
+		assert.Equal(t, "disk almost full", fields[journaldFieldMessage])
+		assert.Equal(t, "4", fields[journaldFieldPriority])
+		assert.Equal(t, filepath.Base(os.Args[0]), fields[journaldFieldIdentifier])
+		assert.Equal(t, fakeLoggerName, fields["SCOPE"])
+		assert.Equal(t, "myapp", fields["APP_ID"])
+		assert.Equal(t, "abc", fields["REQUEST_ID"])
+		assert.Equal(t, "3", fields["COUNT"])
+		assert.Equal(t, "true", fields["F__INTERNAL"])
+	})
+
+	t.Run("reserved field names are prefixed", func(t *testing.T) {
+		testLogger.WithFields(map[string]any{
+			"message":  "from a field",
+			"priority": "high",
+			"errno":    2,
+		}).Error("disk full")
+
+		fields := readJournalEntry(t, journal)
+		assert.Equal(t, "disk full", fields[journaldFieldMessage])
+		assert.Equal(t, "3", fields[journaldFieldPriority])
+		assert.Equal(t, "from a field", fields["F_MESSAGE"])
+		assert.Equal(t, "high", fields["F_PRIORITY"])
+		assert.Equal(t, "2", fields["F_ERRNO"])
+		assert.NotContains(t, fields, "ERRNO")
+	})
+
+	t.Run("priorities", func(t *testing.T) {
+		testLogger.SetOutputLevel(DebugLevel)
+		t.Cleanup(func() { testLogger.SetOutputLevel(InfoLevel) })
+
+		testLogger.Debug("m")
+		assert.Equal(t, "7", readJournalEntry(t, journal)[journaldFieldPriority])
+
+		testLogger.Info("m")
+		assert.Equal(t, "6", readJournalEntry(t, journal)[journaldFieldPriority])
+
+		testLogger.Error("m")
+		assert.Equal(t, "3", readJournalEntry(t, journal)[journaldFieldPriority])
+	})
+
+	t.Run("multi-line message", func(t *testing.T) {
+		testLogger.Error("first line\nsecond line")
+
+		assert.Equal(t, "first line\nsecond line", readJournalEntry(t, journal)[journaldFieldMessage])
+	})
+
+	t.Run("large message", func(t *testing.T) {
+		msg := strings.Repeat("x", 1<<20)
+		testLogger.Info(msg)
+
+		assert.Equal(t, msg, readJournalEntry(t, journal)[journaldFieldMessage])
+	})
+
+	t.Run("write without entry", func(t *testing.T) {
+		_, err := w.Write([]byte("plain\n"))
+		require.NoError(t, err)
+
+		fields := readJournalEntry(t, journal)
+		assert.Equal(t, "plain", fields[journaldFieldMessage])
+		assert.Equal(t, "6", fields[journaldFieldPriority])
+	})
+}
+
+func TestJournaldWriterNoJournal(t *testing.T) {
+	_, err := newJournaldWriter(filepath.Join(t.TempDir(), "missing.sock"))
+	require.Error(t, err)
+}
@@ -0,0 +1,43 @@
+//go:build !linux
+
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"errors"
+)
+
+// DefaultJournaldSocket is the socket of the systemd journal.
+const DefaultJournaldSocket = "/run/systemd/journal/socket"
+
+// JournaldWriter writes log entries to the systemd journal. It is only
+// supported on Linux.
+type JournaldWriter struct{}
+
+// NewJournaldWriter returns an error as the systemd journal is only supported
+// on Linux.
+func NewJournaldWriter() (*JournaldWriter, error) {
+	return nil, errors.New("journald output is only supported on linux")
+}
+
+// Write is never called, as a JournaldWriter can't be created.
+func (w *JournaldWriter) Write(p []byte) (int, error) {
+	return 0, ErrWriterClosed
+}
+
+// Close is a no-op.
+func (w *JournaldWriter) Close() error {
+	return nil
+}
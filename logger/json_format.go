@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// JSONFormat is the layout of the JSON output.
+type JSONFormat string
+
+const (
+	// JSONFormatDefault is the Dapr layout, with the time, level, msg and
+	// scope fields.
+	JSONFormatDefault JSONFormat = ""
+	// JSONFormatECS is the layout of the Elastic Common Schema, see
+	// https://www.elastic.co/guide/en/ecs-logging/overview/current/intro.html.
+	JSONFormatECS JSONFormat = "ecs"
+	// JSONFormatGCP is the structured layout of Google Cloud Logging, see
+	// https://cloud.google.com/logging/docs/structured-logging.
+	JSONFormatGCP JSONFormat = "gcp"
+	// JSONFormatCLEF is the compact log event format of Serilog, for Seq,
+	// see https://clef-json.org.
+	JSONFormatCLEF JSONFormat = "clef"
+)
+
+// toJSONFormat converts to JSONFormat, returning false if format is unknown.
+func toJSONFormat(format string) (JSONFormat, bool) {
+	switch f := JSONFormat(strings.ToLower(format)); f {
+	case JSONFormatDefault, JSONFormatECS, JSONFormatGCP, JSONFormatCLEF:
+		return f, true
+	case "dapr":
+		return JSONFormatDefault, true
+	default:
+		return JSONFormatDefault, false
+	}
+}
+
+// SetJSONFormat sets the layout of the JSON output. It has no effect on the
+// text output.
+func (l *daprLogger) SetJSONFormat(format JSONFormat) {
+	l.core.lock.Lock()
+	defer l.core.lock.Unlock()
+
+	l.core.jsonFormat = format
+	l.logger.Logger.SetFormatter(l.newFormatterLocked(isJSONFormatter(l.logger.Logger.Formatter)))
+}
+
+// isJSONFormatter returns true if f formats the entries as JSON. The formatter
+// of a logger must be read under core.lock, as it is replaced under it.
+func isJSONFormatter(f logrus.Formatter) bool {
+	switch f := f.(type) {
+	case *logrus.TextFormatter, *prettyFormatter:
+		return false
+	case *cborFormatter:
+		return f.jsonEnabled
+	default:
+		return true
+	}
+}
+
+// marshalJSONEntry returns v encoded as a JSON line.
+func marshalJSONEntry(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, fmt.Errorf("failed to marshal fields to JSON, %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// jsonFieldValue returns v as encoded by the logrus JSON formatter, which
+// writes the errors as their message.
+func jsonFieldValue(v any) any {
+	if err, ok := v.(error); ok {
+		return err.Error()
+	}
+
+	return v
+}
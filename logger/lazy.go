@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+// LazyValue is a field value computed only when an entry is written, see Lazy.
+// It isn't a func, as logrus discards the fields with a func value.
+type LazyValue struct {
+	fn func() any
+}
+
+// Lazy returns a field value computed by fn only when an entry with the field
+// is logged at an enabled level, for expensive values such as:
+//
+//	log.WithFields(map[string]any{
+//		"queue_depth": logger.Lazy(func() any { return q.Len() }),
+//	}).Debug("Queued message")
+//
+// fn is called once per entry, so it must be safe to call concurrently if the
+// logger is.
+func Lazy(fn func() any) LazyValue {
+	return LazyValue{fn: fn}
+}
+
+// resolveLazyFields replaces the lazy values of data with their value.
+func resolveLazyFields(data logrus.Fields) {
+	for k, v := range data {
+		if lazy, ok := v.(LazyValue); ok {
+			data[k] = lazy.fn()
+		}
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLazy(t *testing.T) {
+	var buf bytes.Buffer
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	calls := 0
+	depth := Lazy(func() any {
+		calls++
+		return 42
+	})
+	withDepth := testLogger.WithFields(map[string]any{"queue_depth": depth})
+
+	withDepth.Debug("disabled")
+	assert.Equal(t, 0, calls)
+	assert.Empty(t, buf.String())
+
+	withDepth.Info("enabled")
+	assert.Equal(t, 1, calls)
+
+	o := readTestEntry(t, &buf)
+	assert.InDelta(t, 42, o["queue_depth"], 0)
+
+	// Each entry computes the value again.
+	withDepth.Info("enabled again")
+	assert.Equal(t, 2, calls)
+}
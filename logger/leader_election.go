@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+const (
+	logFieldCandidate    = "candidate"
+	logFieldIsLeader     = "is_leader"
+	logFieldElectionTerm = "election_term"
+)
+
+func leaderElectionFields(candidate string, isLeader bool, term int) map[string]any {
+	return map[string]any{
+		logFieldCandidate:    candidate,
+		logFieldIsLeader:     isLeader,
+		logFieldElectionTerm: term,
+	}
+}
+
+// LogLeaderElection logs at level Info the leader election state of candidate
+// for the given term.
+func (l *daprLogger) LogLeaderElection(candidate string, isLeader bool, term int) {
+	l.WithFields(leaderElectionFields(candidate, isLeader, term)).
+		Infof("Leader election for term %d: %s is leader: %t", term, candidate, isLeader)
+}
+
+// LogLeadershipTransition logs at level Warn that candidate gained or lost
+// leadership in the given term.
+func (l *daprLogger) LogLeadershipTransition(candidate string, isLeader bool, term int) {
+	fields := leaderElectionFields(candidate, isLeader, term)
+	if isLeader {
+		l.WithFields(fields).Warnf("%s gained leadership for term %d", candidate, term)
+		return
+	}
+
+	l.WithFields(fields).Warnf("%s lost leadership for term %d", candidate, term)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeaderElectionLogs(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	testLogger.LogLeaderElection("placement-0", false, 3)
+	o := readTestEntry(t, &buf)
+	assert.Equal(t, "info", o[logFieldLevel])
+	assert.Equal(t, "placement-0", o[logFieldCandidate])
+	assert.Equal(t, false, o[logFieldIsLeader])
+	assert.InDelta(t, float64(3), o[logFieldElectionTerm], 0)
+
+	testLogger.LogLeadershipTransition("placement-0", true, 4)
+	o = readTestEntry(t, &buf)
+	assert.Equal(t, "warning", o[logFieldLevel])
+	assert.Equal(t, "placement-0 gained leadership for term 4", o[logFieldMessage])
+	assert.Equal(t, true, o[logFieldIsLeader])
+	assert.InDelta(t, float64(4), o[logFieldElectionTerm], 0)
+
+	testLogger.LogLeadershipTransition("placement-0", false, 5)
+	o = readTestEntry(t, &buf)
+	assert.Equal(t, "warning", o[logFieldLevel])
+	assert.Equal(t, "placement-0 lost leadership for term 5", o[logFieldMessage])
+	assert.Equal(t, false, o[logFieldIsLeader])
+}
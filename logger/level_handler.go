@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// levelRequest is the body of the PUT requests of LevelHandler.
+type levelRequest struct {
+	Level string `json:"level"`
+}
+
+// levelResponse is the body of the responses of LevelHandler.
+type levelResponse struct {
+	// Logger is the name of the logger, empty for all loggers.
+	Logger string `json:"logger,omitempty"`
+	// Level is the output level of Logger.
+	Level LogLevel `json:"level,omitempty"`
+	// Loggers are the output levels of all loggers.
+	Loggers map[string]LogLevel `json:"loggers,omitempty"`
+}
+
+// LevelHandler returns a handler to get and set at runtime the output level of
+// the loggers created with NewLogger:
+//
+//	GET /?logger=<name>  returns {"logger":"<name>","level":"info"}
+//	GET /?logger=<scope> returns {"loggers":{"<scope>.<name>":"info",...}} without a logger named <scope>
+//	GET /                returns {"loggers":{"<name>":"info",...}}
+//	PUT /?logger=<name>  with {"level":"debug"} sets the level of the logger and its descendants
+//	PUT /                with {"level":"debug"} sets the level of all loggers
+//
+// The descendants of a logger are the loggers whose name starts with its name
+// and a dot, such as runtime.actors.placement for runtime.actors.
+func LevelHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Query().Get("logger")
+
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			var req levelRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, fmt.Sprintf("invalid request body: %v", err), http.StatusBadRequest)
+				return
+			}
+
+			level := toLogLevel(req.Level)
+			if level == UndefinedLevel {
+				http.Error(w, "undefined log level: "+req.Level, http.StatusBadRequest)
+				return
+			}
+
+			if !setLoggersLevel(name, level) {
+				http.Error(w, "logger not found: "+name, http.StatusNotFound)
+				return
+			}
+		default:
+			w.Header().Set("Allow", http.MethodGet+", "+http.MethodPut)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		res, ok := loggersLevel(name)
+		if !ok {
+			http.Error(w, "logger not found: "+name, http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(res)
+	})
+}
+
+// setLoggersLevel sets the output level of the logger name and its
+// descendants, or of all loggers if name is empty. It returns false if neither
+// the logger nor a descendant exists.
+func setLoggersLevel(name string, level LogLevel) bool {
+	found := false
+	for n, l := range getLoggers() {
+		if name == "" || n == name || isDescendant(n, name) {
+			l.SetOutputLevel(level)
+			found = true
+		}
+	}
+
+	return found || name == ""
+}
+
+// loggersLevel returns the output level of the logger name, or else of its
+// descendants, or of all loggers if name is empty. It returns false if neither
+// the logger nor a descendant exists.
+func loggersLevel(name string) (levelResponse, bool) {
+	loggers := getLoggers()
+	if l, ok := loggers[name]; ok && name != "" {
+		return levelResponse{Logger: name, Level: outputLevel(l)}, true
+	}
+
+	res := levelResponse{Loggers: make(map[string]LogLevel, len(loggers))}
+	for n, l := range loggers {
+		if name == "" || isDescendant(n, name) {
+			res.Loggers[n] = outputLevel(l)
+		}
+	}
+
+	return res, name == "" || len(res.Loggers) > 0
+}
+
+// outputLevel returns the most verbose level l outputs.
+func outputLevel(l Logger) LogLevel {
+	for _, level := range []LogLevel{TraceLevel, DebugLevel, InfoLevel, WarnLevel, ErrorLevel, FatalLevel, PanicLevel} {
+		if l.IsOutputLevelEnabled(level) {
+			return level
+		}
+	}
+
+	return UndefinedLevel
+}
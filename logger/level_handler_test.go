@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLevelHandler(t *testing.T) {
+	logger1 := NewLogger("levelHandlerLogger1")
+	logger2 := NewLogger("levelHandlerLogger2")
+	logger1.SetOutputLevel(InfoLevel)
+	logger2.SetOutputLevel(WarnLevel)
+	t.Cleanup(func() {
+		for _, l := range getLoggers() {
+			l.SetOutputLevel(InfoLevel)
+		}
+	})
+
+	handler := LevelHandler()
+
+	do := func(t *testing.T, method, target, body string) (int, levelResponse) {
+		t.Helper()
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(method, target, strings.NewReader(body)))
+
+		var res levelResponse
+		if rec.Code == http.StatusOK {
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		}
+
+		return rec.Code, res
+	}
+
+	t.Run("get logger", func(t *testing.T) {
+		code, res := do(t, http.MethodGet, "/?logger=levelHandlerLogger2", "")
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, levelResponse{Logger: "levelHandlerLogger2", Level: WarnLevel}, res)
+	})
+
+	t.Run("get all loggers", func(t *testing.T) {
+		code, res := do(t, http.MethodGet, "/", "")
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, InfoLevel, res.Loggers["levelHandlerLogger1"])
+		assert.Equal(t, WarnLevel, res.Loggers["levelHandlerLogger2"])
+	})
+
+	t.Run("put logger", func(t *testing.T) {
+		code, res := do(t, http.MethodPut, "/?logger=levelHandlerLogger1", `{"level":"debug"}`)
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, DebugLevel, res.Level)
+
+		assert.True(t, logger1.IsOutputLevelEnabled(DebugLevel))
+		assert.False(t, logger2.IsOutputLevelEnabled(InfoLevel))
+	})
+
+	t.Run("put all loggers", func(t *testing.T) {
+		code, res := do(t, http.MethodPut, "/", `{"level":"error"}`)
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, ErrorLevel, res.Loggers["levelHandlerLogger1"])
+
+		assert.False(t, logger1.IsOutputLevelEnabled(WarnLevel))
+		assert.False(t, logger2.IsOutputLevelEnabled(WarnLevel))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		code, _ := do(t, http.MethodGet, "/?logger=unknown", "")
+		assert.Equal(t, http.StatusNotFound, code)
+
+		code, _ = do(t, http.MethodPut, "/?logger=unknown", `{"level":"debug"}`)
+		assert.Equal(t, http.StatusNotFound, code)
+
+		code, _ = do(t, http.MethodPut, "/", `{"level":"verbose"}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		code, _ = do(t, http.MethodPut, "/", `not json`)
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		code, _ = do(t, http.MethodPost, "/", "")
+		assert.Equal(t, http.StatusMethodNotAllowed, code)
+	})
+}
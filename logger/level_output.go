@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"io"
+	"maps"
+
+	"github.com/sirupsen/logrus"
+)
+
+// LevelWriter is an output which is given the level of each entry written to
+// it, such as to map it to the severity of the destination.
+// The logger calls WriteLevel in place of Write on outputs implementing it.
+type LevelWriter interface {
+	io.Writer
+	WriteLevel(lvl LogLevel, p []byte) (int, error)
+}
+
+// EntryWriter is an output which is given each entry written to it along with
+// its formatted bytes, such as to forward its fields as structured data.
+// The logger calls WriteEntry in place of Write on outputs implementing it.
+type EntryWriter interface {
+	io.Writer
+	WriteEntry(e Entry, p []byte) (int, error)
+}
+
+// writeOutput writes p, the formatted entry, to w, with the entry if w is an
+// EntryWriter or its level if w is a LevelWriter.
+func writeOutput(w io.Writer, entry *logrus.Entry, p []byte) (int, error) {
+	switch w := w.(type) {
+	case EntryWriter:
+		return w.WriteEntry(newEntry(entry), p)
+	case LevelWriter:
+		return w.WriteLevel(fromLogrusLevel(entry.Level), p)
+	default:
+		return w.Write(p)
+	}
+}
+
+// SetOutputForLevels sets the destination of the entries at the given levels,
+// in place of the output set with SetOutput. For example, Warn and Error
+// entries can be written to os.Stderr and the others to os.Stdout.
+// A nil w restores the output for the levels.
+func (l *daprLogger) SetOutputForLevels(levels []LogLevel, w io.Writer) {
+	l.core.lock.Lock()
+	defer l.core.lock.Unlock()
+
+	outputs := maps.Clone(l.core.levelOutputs)
+	if outputs == nil {
+		outputs = make(map[logrus.Level]io.Writer, len(levels))
+	}
+
+	for _, lvl := range levels {
+		if lvl == UndefinedLevel {
+			continue
+		}
+
+		if w == nil {
+			delete(outputs, toLogrusLevel(lvl))
+		} else {
+			outputs[toLogrusLevel(lvl)] = w
+		}
+	}
+
+	l.core.levelOutputs = outputs
+}
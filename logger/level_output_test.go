@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetOutputForLevels(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	testLogger := getTestLogger(&stdout)
+	testLogger.SetOutputLevel(DebugLevel)
+	testLogger.SetOutputForLevels([]LogLevel{WarnLevel, ErrorLevel}, &stderr)
+
+	t.Run("routed per level", func(t *testing.T) {
+		testLogger.Debug("debug entry")
+		testLogger.Info("info entry")
+		testLogger.Warn("warn entry")
+		testLogger.WithFields(map[string]any{"attempt": 1}).Error("error entry")
+
+		assert.Equal(t, 2, strings.Count(stdout.String(), "\n"))
+		assert.Contains(t, stdout.String(), "debug entry")
+		assert.Contains(t, stdout.String(), "info entry")
+
+		assert.Equal(t, 2, strings.Count(stderr.String(), "\n"))
+		assert.Contains(t, stderr.String(), "warn entry")
+		assert.Contains(t, stderr.String(), "error entry")
+	})
+
+	t.Run("SetOutput keeps the level outputs", func(t *testing.T) {
+		var other bytes.Buffer
+		stderr.Reset()
+
+		testLogger.SetOutput(&other)
+		testLogger.Info("info entry")
+		testLogger.Error("error entry")
+
+		assert.Contains(t, other.String(), "info entry")
+		assert.Contains(t, stderr.String(), "error entry")
+		testLogger.SetOutput(&stdout)
+	})
+
+	t.Run("restored output", func(t *testing.T) {
+		stdout.Reset()
+		stderr.Reset()
+
+		testLogger.SetOutputForLevels([]LogLevel{WarnLevel}, nil)
+		testLogger.Warn("warn entry")
+		testLogger.Error("error entry")
+
+		assert.Contains(t, stdout.String(), "warn entry")
+		assert.Contains(t, stderr.String(), "error entry")
+	})
+}
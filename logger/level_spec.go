@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// levelSpecRule sets the level of the loggers whose name matches pattern.
+type levelSpecRule struct {
+	pattern string
+	level   LogLevel
+}
+
+// levelSpec is a parsed level spec, see ApplyLevelSpec.
+type levelSpec struct {
+	// defaultLevel is the level of the loggers matching no rule, or
+	// UndefinedLevel to leave them unchanged
+	defaultLevel LogLevel
+	rules        []levelSpecRule
+}
+
+// isLevelSpec returns true if s sets levels per logger, rather than being a
+// single level.
+func isLevelSpec(s string) bool {
+	return strings.ContainsAny(s, ",:")
+}
+
+// parseLevelSpec parses a comma-separated list of levels, each optionally
+// prefixed with a logger name pattern and a colon.
+func parseLevelSpec(spec string) (levelSpec, error) {
+	res := levelSpec{defaultLevel: UndefinedLevel}
+
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		pattern, lvlStr, hasPattern := strings.Cut(part, ":")
+		if !hasPattern {
+			lvlStr = pattern
+		}
+
+		lvl := toLogLevel(strings.TrimSpace(lvlStr))
+		if lvl == UndefinedLevel {
+			return levelSpec{}, fmt.Errorf("invalid level %q in level spec %q", lvlStr, spec)
+		}
+
+		if !hasPattern {
+			res.defaultLevel = lvl
+			continue
+		}
+
+		pattern = strings.TrimSpace(pattern)
+		if _, err := path.Match(pattern, ""); err != nil || pattern == "" {
+			return levelSpec{}, fmt.Errorf("invalid logger pattern %q in level spec %q", pattern, spec)
+		}
+		res.rules = append(res.rules, levelSpecRule{pattern: pattern, level: lvl})
+	}
+
+	return res, nil
+}
+
+// levelFor returns the level of the logger name: the level of the last rule it
+// matches, or else of the rule matching its nearest ancestor, or else the
+// default level.
+func (s levelSpec) levelFor(name string) LogLevel {
+	lvl := s.defaultLevel
+	matched := false
+	ancestorDepth := 0
+	for _, r := range s.rules {
+		if ok, _ := path.Match(r.pattern, name); ok {
+			lvl = r.level
+			matched = true
+			continue
+		}
+
+		if matched {
+			continue
+		}
+		if d := scopeDepth(r.pattern); d >= ancestorDepth && matchesAncestor(r.pattern, name) {
+			lvl = r.level
+			ancestorDepth = d
+		}
+	}
+
+	return lvl
+}
+
+// matchesAncestor returns true if pattern matches one of the dot-separated
+// ancestors of name.
+func matchesAncestor(pattern, name string) bool {
+	for i := strings.LastIndex(name, scopeSeparator); i > 0; i = strings.LastIndex(name[:i], scopeSeparator) {
+		if ok, _ := path.Match(pattern, name[:i]); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ApplyLevelSpec sets the output level of the registered loggers from spec,
+// a comma-separated list of levels each optionally prefixed with a logger
+// name pattern, such as:
+//
+//	info,components.state:debug,grpc.*:warn
+//
+// Patterns use the syntax of path.Match, where * matches any sequence of
+// characters. A logger takes the level of the last pattern its name matches,
+// or else of the deepest pattern one of its dot-separated ancestors matches,
+// so that runtime.actors cascades to runtime.actors.placement, or else the
+// level without pattern. Loggers matching nothing are unchanged.
+func ApplyLevelSpec(spec string) error {
+	s, err := parseLevelSpec(spec)
+	if err != nil {
+		return err
+	}
+
+	for name, l := range getLoggers() {
+		if lvl := s.levelFor(name); lvl != UndefinedLevel {
+			l.SetOutputLevel(lvl)
+		}
+	}
+
+	return nil
+}
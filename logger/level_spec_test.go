@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLevelSpec(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		s, err := parseLevelSpec(" info, components.state:debug ,grpc.*:warn,grpc.server:error")
+		require.NoError(t, err)
+
+		assert.Equal(t, InfoLevel, s.levelFor("dapr.runtime"))
+		assert.Equal(t, DebugLevel, s.levelFor("components.state"))
+		// The descendants take the level of their ancestors.
+		assert.Equal(t, DebugLevel, s.levelFor("components.state.redis"))
+		assert.Equal(t, InfoLevel, s.levelFor("components.statestore"))
+		assert.Equal(t, WarnLevel, s.levelFor("grpc.client"))
+		// The last matching pattern wins.
+		assert.Equal(t, ErrorLevel, s.levelFor("grpc.server"))
+	})
+
+	t.Run("without default", func(t *testing.T) {
+		s, err := parseLevelSpec("components.*:debug")
+		require.NoError(t, err)
+
+		assert.Equal(t, DebugLevel, s.levelFor("components.pubsub"))
+		assert.Equal(t, UndefinedLevel, s.levelFor("dapr.runtime"))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, spec := range []string{
+			"verbose",
+			"info,components.state:verbose",
+			"info,:debug",
+			"info,grpc.[:debug",
+		} {
+			_, err := parseLevelSpec(spec)
+			require.Error(t, err, spec)
+		}
+	})
+}
+
+func TestApplyLevelSpec(t *testing.T) {
+	state := NewLogger("levelspec.components.state")
+	pubsub := NewLogger("levelspec.components.pubsub")
+	grpcLogger := NewLogger("levelspec.grpc.server")
+	t.Cleanup(func() {
+		for _, l := range []Logger{state, pubsub, grpcLogger} {
+			l.SetOutputLevel(InfoLevel)
+		}
+	})
+
+	require.NoError(t, ApplyLevelSpec("levelspec.*:error,levelspec.components.state:debug,levelspec.grpc.*:warn"))
+
+	assert.True(t, state.IsOutputLevelEnabled(DebugLevel))
+	assert.False(t, pubsub.IsOutputLevelEnabled(WarnLevel))
+	assert.True(t, pubsub.IsOutputLevelEnabled(ErrorLevel))
+	assert.False(t, grpcLogger.IsOutputLevelEnabled(InfoLevel))
+	assert.True(t, grpcLogger.IsOutputLevelEnabled(WarnLevel))
+
+	require.Error(t, ApplyLevelSpec("levelspec.*:loud"))
+	assert.True(t, state.IsOutputLevelEnabled(DebugLevel))
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+const (
+	logFieldResource     = "resource"
+	logFieldCurrent      = "current"
+	logFieldLimit        = "limit"
+	logFieldLimitPercent = "limit_pct"
+)
+
+// LogLimitApproaching logs at level Warn that the usage of resource is close
+// to its limit, when current/limit is at least warnAtPct (a fraction, e.g. 0.8).
+// The usage percentage is logged in limit_pct. Nothing is logged below the
+// threshold or when limit isn't positive.
+func (l *daprLogger) LogLimitApproaching(resource string, current, limit int64, warnAtPct float64) {
+	if limit <= 0 {
+		return
+	}
+
+	ratio := float64(current) / float64(limit)
+	if ratio < warnAtPct {
+		return
+	}
+
+	pct := ratio * 100
+	l.WithFields(map[string]any{
+		logFieldResource:     resource,
+		logFieldCurrent:      current,
+		logFieldLimit:        limit,
+		logFieldLimitPercent: pct,
+	}).Warnf("Resource %s is at %.1f%% of its limit (%d/%d)", resource, pct, current, limit)
+}
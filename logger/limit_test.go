@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogLimitApproaching(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	t.Run("above threshold", func(t *testing.T) {
+		testLogger.LogLimitApproaching("connections", 90, 100, 0.8)
+
+		o := readTestEntry(t, &buf)
+		buf.Reset()
+
+		assert.Equal(t, "warning", o[logFieldLevel])
+		assert.Equal(t, "connections", o[logFieldResource])
+		assert.InDelta(t, float64(90), o[logFieldCurrent], 0)
+		assert.InDelta(t, float64(100), o[logFieldLimit], 0)
+		assert.InDelta(t, float64(90), o[logFieldLimitPercent], 1e-9)
+	})
+
+	t.Run("at threshold", func(t *testing.T) {
+		testLogger.LogLimitApproaching("connections", 80, 100, 0.8)
+
+		assert.NotZero(t, buf.Len())
+		buf.Reset()
+	})
+
+	t.Run("below threshold", func(t *testing.T) {
+		testLogger.LogLimitApproaching("connections", 79, 100, 0.8)
+
+		assert.Zero(t, buf.Len())
+	})
+
+	t.Run("no limit", func(t *testing.T) {
+		testLogger.LogLimitApproaching("connections", 79, 0, 0.8)
+
+		assert.Zero(t, buf.Len())
+	})
+}
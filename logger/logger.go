@@ -16,9 +16,13 @@ package logger
 import (
 	"context"
 	"io"
+	"log"
 	"maps"
 	"strings"
 	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -26,6 +30,8 @@ const (
 	LogTypeLog = "log"
 	// LogTypeRequest is Request log type.
 	LogTypeRequest = "request"
+	// LogTypeAudit is Audit log type.
+	LogTypeAudit = "audit"
 
 	// Field names that defines Dapr log schema.
 	logFieldTimeStamp = "time"
@@ -36,6 +42,8 @@ const (
 	logFieldInstance  = "instance"
 	logFieldDaprVer   = "ver"
 	logFieldAppID     = "app_id"
+
+	logFieldComponentVer = "component_version"
 )
 
 type logContextKeyType struct{}
@@ -47,6 +55,8 @@ var logContextKey = logContextKeyType{}
 type LogLevel string
 
 const (
+	// TraceLevel is for the most verbose messages, such as wire-format logging.
+	TraceLevel LogLevel = "trace"
 	// DebugLevel has verbose message.
 	DebugLevel LogLevel = "debug"
 	// InfoLevel is default log level.
@@ -57,6 +67,8 @@ const (
 	ErrorLevel LogLevel = "error"
 	// FatalLevel is for logging fatal messages. The system shuts down after logging the message.
 	FatalLevel LogLevel = "fatal"
+	// PanicLevel is for logging messages right before panicking.
+	PanicLevel LogLevel = "panic"
 
 	// UndefinedLevel is for undefined log level.
 	UndefinedLevel LogLevel = "undefined"
@@ -74,28 +86,248 @@ var (
 type Logger interface { //nolint: interfacebloat
 	// EnableJSONOutput enables JSON formatted output log
 	EnableJSONOutput(enabled bool)
+	// SetJSONFormat sets the layout of the JSON output. Default value is JSONFormatDefault
+	SetJSONFormat(format JSONFormat)
+	// SetOutputEncoding sets the encoding of the output. Default value is OutputEncodingText
+	SetOutputEncoding(encoding OutputEncoding)
 
 	// SetAppID sets dapr_id field in the log. Default value is empty string
 	SetAppID(id string)
 
+	// SetComponentVersion sets component_version field in the log. Default value is empty string
+	SetComponentVersion(version string)
+
+	// SetSchemaVersion sets schema_version field in the log. Default value is DefaultSchemaVersion
+	SetSchemaVersion(version string)
+
 	// SetOutputLevel sets the log output level
 	SetOutputLevel(outputLevel LogLevel)
+	// AddSink adds a destination receiving the entries in addition to the output, with its own format and level.
+	AddSink(w io.Writer, opts SinkOptions)
 	// SetOutput sets the destination for the logs
 	SetOutput(dst io.Writer)
 
+	// SetWriteFunc sets the function writing the entries, bypassing the formatter and output.
+	SetWriteFunc(fn func(Entry) error)
+
+	// SetOutputForLevels sets the destination of the entries at the given levels, in place of the output
+	SetOutputForLevels(levels []LogLevel, w io.Writer)
+
+	// SetEnabledLevels sets the exact levels to output, as an alternative to the
+	// output level threshold. Calling it without levels restores the threshold.
+	SetEnabledLevels(levels ...LogLevel)
+
 	// IsOutputLevelEnabled returns true if the logger will output this LogLevel.
 	IsOutputLevelEnabled(level LogLevel) bool
 
+	// SetFieldKeyCase sets the case custom field keys are converted to. Default value is CaseAsIs
+	SetFieldKeyCase(c FieldKeyCase)
+
+	// SetSizeLimits sets the maximum size in bytes of the message and string fields, longer ones are truncated. Default value is 0, which disables the limit
+	SetSizeLimits(maxMessageBytes, maxFieldBytes int)
+	// SetFieldFilter drops the fields matching deny and, if allow isn't empty, the fields not matching allow, the envelope fields excepted. Default value is no filter
+	SetFieldFilter(allow, deny []string)
+	// SetFloatPrecision sets the decimal places float fields are rounded to. Default value is -1, which disables the rounding
+	SetFloatPrecision(digits int)
+
+	// EnableStackTrace adds the stack of the caller of WithError in the stacktrace field. Default value is false
+	EnableStackTrace(enabled bool)
+	// EnableStructuredErrors replaces the error_causes field of WithError with the error.causes array of the messages and types of the wrapped errors, also added by the f-functions for the errors wrapped with %w. Default value is false
+	EnableStructuredErrors(enabled bool)
+
+	// EnableCaller adds the file:line and function the entries are logged from in the caller and func fields. Default value is false
+	EnableCaller(enabled bool)
+	// ForceColors enables the colorized text output even if the output isn't a terminal. Default value is false
+	ForceColors(enabled bool)
+	// SetTimestampFormat sets the layout of the time field and whether it is in UTC. Default value is time.RFC3339Nano in the local time zone
+	SetTimestampFormat(layout string, utc bool)
+
+	// SetCompactEnvelope moves the scope, type, instance and app_id fields of JSON entries into the m field. Default value is false
+	SetCompactEnvelope(enabled bool)
+
+	// SetFrameHeader sets the header prepended to each formatted entry. Default value is FrameNone
+	SetFrameHeader(h FrameHeader)
+
+	// SetPartitioning adds to each entry a partition field computed from the hash of keyField.
+	SetPartitioning(keyField string, numPartitions int)
+
 	// WithLogType specifies the log_type field in log. Default value is LogTypeLog
 	WithLogType(logType string) Logger
 
 	// WithFields returns a logger with the added structured fields.
 	WithFields(fields map[string]any) Logger
 
+	// Child returns the logger named after the name of this logger and suffix, separated with a dot, inheriting its output level, output and format when created
+	Child(suffix string) Logger
+
+	// WithError returns a logger with the error, error_type and error_causes fields of err, and the stacktrace field if enabled
+	WithError(err error) Logger
+
+	// DefineChannel defines a named channel writing to w the entries at least as severe as level.
+	DefineChannel(name string, w io.Writer, level LogLevel)
+	// ToChannel returns a logger whose entries are filtered and written by the named channel.
+	ToChannel(name string) Logger
+
+	// WithContext returns a logger carrying the fields found in ctx, such as the span trace flags.
+	WithContext(ctx context.Context) Logger
+	// WithSpanLink returns a logger carrying the IDs, trace flags and sampled bit of the linked span.
+	WithSpanLink(link trace.SpanContext) Logger
+
+	// WithOrigin returns a logger with the origin field, for entries relayed from other nodes.
+	WithOrigin(node string) Logger
+	// Relay re-emits an entry, such as one parsed with ParseEntry, preserving its origin.
+	Relay(e Entry)
+
+	// WithVersionConflict returns a logger with the versions of an optimistic concurrency conflict, logging at least at level Warn.
+	WithVersionConflict(resource string, expected, actual int64) Logger
+
+	// WithBinding returns a logger with the binding_name and binding_operation fields.
+	WithBinding(name, operation string) Logger
+	// LogBindingResult logs the outcome of a binding invocation, at level Error on failure.
+	LogBindingResult(success bool, err error, duration time.Duration)
+
+	// WithTokenBucket returns a logger with the state of a token bucket rate limiter.
+	WithTokenBucket(name string, tokens, capacity float64, refillPerSec float64) Logger
+
+	// WithStream returns a logger with the grpc_method and stream_id fields.
+	WithStream(method string, streamID string) Logger
+	// LogStreamEvent logs a lifecycle event of a stream with the number of messages exchanged.
+	LogStreamEvent(event string, messageCount int)
+
+	// WithCompressedField returns a logger with data gzipped and base64-encoded in the key field.
+	WithCompressedField(key string, data []byte) Logger
+
+	// WithPayloadSnippet returns a logger with up to maxBytes of payload in the payload_snippet field.
+	WithPayloadSnippet(payload []byte, maxBytes int) Logger
+	// LogSerdeError logs at level Error a failure to encode or decode a payload.
+	LogSerdeError(format string, op string, err error, payloadLen int)
+
+	// WithSensitivity returns a logger whose entries carry the sensitivity classification.
+	WithSensitivity(level SensitivityLevel) Logger
+	// SetAuditedSink sets the destination receiving a copy of the Restricted entries.
+	SetAuditedSink(w io.Writer)
+	// SetAuditWriter sets the destination of the audit entries, synced after each write.
+	SetAuditWriter(w io.Writer)
+
+	// WithBackoff returns a logger with the retry attempt and its base and jittered backoff in milliseconds.
+	WithBackoff(attempt int, base, jittered time.Duration) Logger
+
+	// WithTenant returns a logger with the tenant_id field, routed to the tenant sink when tenant routing is set.
+	WithTenant(tenantID string) Logger
+	// SetTenantRouting sets the function returning the destination of the entries of a tenant.
+	SetTenantRouting(route func(tenantID string) io.Writer)
+
+	// WithConnectionRetry records an attempt to connect to target, whose outcome is the level of the first entry logged with the returned logger.
+	WithConnectionRetry(target string, attempt int) Logger
+	// LogConnectionStats logs at level Info the attempts, failures and last success recorded for target.
+	LogConnectionStats(target string)
+
+	// TrackFieldCardinality starts estimating the number of distinct values of the field key.
+	TrackFieldCardinality(key string)
+	// LogCardinalityWarning logs at level Warn when the estimated distinct values of key exceed threshold.
+	LogCardinalityWarning(key string, threshold int)
+
+	// EnableDegradationTracking marks error entries with degraded=true while the
+	// share of errors logged within window exceeds threshold.
+	EnableDegradationTracking(window time.Duration, threshold float64)
+
+	// OnWrite registers a callback invoked after each entry is written, with the
+	// entry, the number of bytes written and the write error.
+	OnWrite(fn func(e Entry, n int, err error))
+
+	// AddHook registers a hook invoked with each entry written at one of levels, or at any level if levels is empty.
+	AddHook(levels []LogLevel, fn func(Record))
+
+	// EnableEntryRetention keeps in memory the last size entries written, for the support bundle.
+	EnableEntryRetention(size int)
+	// WriteSupportBundle writes the logger configuration and the retained entries to a JSON file at path.
+	WriteSupportBundle(path string) error
+
+	// NewEntryTimer returns a timer whose entries carry the elapsed milliseconds in build_ms.
+	NewEntryTimer() *EntryTimer
+
+	// LogDNSResolution logs the outcome of resolving host, at level Warn on failure.
+	LogDNSResolution(host string, addrs []string, duration time.Duration, err error)
+
+	// LogShutdownPhase logs at level Info the current graceful shutdown phase.
+	LogShutdownPhase(phase string, remaining time.Duration)
+	// LogShutdownComplete logs at level Info the graceful shutdown completion.
+	LogShutdownComplete(duration time.Duration)
+
+	// LogValidation logs the outcome of validating a request, at level Warn on failure.
+	LogValidation(requestID string, valid bool, errs []FieldError, duration time.Duration)
+
+	// LogSchemaViolations logs at level Error the JSON Schema violations of a document.
+	LogSchemaViolations(documentID string, violations []SchemaViolation)
+
+	// LogRuntimeStats logs at level Debug a snapshot of the memory and GC stats.
+	LogRuntimeStats()
+
+	// SetSampling keeps at random only the given share of the entries below level Error.
+	SetSampling(rate float64)
+	// SetSamplerSeed seeds the random generator of the sampling, making its decisions reproducible.
+	SetSamplerSeed(seed int64)
+	// SetMessageSampling writes the first entries with the same level and message per interval, then 1 in M of them.
+	SetMessageSampling(opts SamplingOptions)
+
+	// SuppressRepeats drops identical consecutive entries within window and writes a "last message repeated N times" entry instead.
+	SuppressRepeats(window time.Duration)
+	// EnableCoalescing collapses identical consecutive entries into one with repeat_count.
+	EnableCoalescing(maxHold time.Duration)
+
+	// LogLeaderElection logs at level Info the leader election state of a candidate.
+	LogLeaderElection(candidate string, isLeader bool, term int)
+	// LogLeadershipTransition logs at level Warn that a candidate gained or lost leadership.
+	LogLeadershipTransition(candidate string, isLeader bool, term int)
+
+	// Instrument logs the start and completion of fn, returning the error returned by fn.
+	Instrument(name string, fn func() error) error
+
+	// LogReadiness logs the readiness state of a component, only when it changes.
+	LogReadiness(component string, ready bool, reason string)
+
+	// LogEviction logs at level Debug a cache eviction, at level Warn if the reason is unknown.
+	LogEviction(cacheName, key, reason string)
+
+	// LogLimitApproaching logs at level Warn when current/limit is at least warnAtPct.
+	LogLimitApproaching(resource string, current, limit int64, warnAtPct float64)
+
+	// LogCertExpiry logs the expiry of a certificate, at level Warn when it expires within warnWithin.
+	LogCertExpiry(subject string, notAfter time.Time, warnWithin time.Duration)
+
+	// LogWindowRollover logs at level Debug the bounds and counts of a rate limiter window that ended.
+	LogWindowRollover(limiter string, windowStart, windowEnd time.Time, allowed, denied int64)
+
+	// LogIdempotencyCollision logs at level Warn that a request reused an idempotency key.
+	LogIdempotencyCollision(key string, firstSeen time.Time)
+
+	// LogRebalance logs at level Info the partitions assigned to and revoked from a consumer group.
+	LogRebalance(group string, assigned, revoked []int)
+
+	// SetQueueHighWaterMark sets the percentage of the capacity of a queue above which LogQueueDepth logs at level Warn.
+	SetQueueHighWaterMark(pct float64)
+	// LogQueueDepth logs the depth of a queue, at level Warn above the high-water mark.
+	LogQueueDepth(queue string, depth, capacity int)
+
+	// LogSelfCheck logs the result of a self-check with its details, at level Error on failure.
+	LogSelfCheck(name string, passed bool, details map[string]any)
+
+	// LogDrain logs the progress of draining the connections of a listener, at level Warn past the deadline.
+	LogDrain(listener string, active, drained int, deadline time.Time)
+
+	// LogConfigReload logs the changes between two configurations under config_changes.
+	LogConfigReload(oldConfig, newConfig map[string]any)
+
 	// Info logs a message at level Info.
 	Info(args ...any)
+	// InfoWithNote logs a message at level Info annotated with a note.
+	InfoWithNote(msg, note string)
 	// Infof logs a message at level Info.
 	Infof(format string, args ...any)
+	// Trace logs a message at level Trace.
+	Trace(args ...any)
+	// Tracef logs a message at level Trace.
+	Tracef(format string, args ...any)
 	// Debug logs a message at level Debug.
 	Debug(args ...any)
 	// Debugf logs a message at level Debug.
@@ -112,11 +344,23 @@ type Logger interface { //nolint: interfacebloat
 	Fatal(args ...any)
 	// Fatalf logs a message at level Fatal then the process will exit with status set to 1.
 	Fatalf(format string, args ...any)
+	// Audit logs an audit entry, written and synced before it returns. It is never sampled or dropped.
+	Audit(args ...any) error
+	// Auditf logs an audit entry, written and synced before it returns. It is never sampled or dropped.
+	Auditf(format string, args ...any) error
+	// StdLogger returns a *log.Logger whose writes are logged at the given level.
+	StdLogger(level LogLevel) *log.Logger
+	// Panic logs a message at level Panic then panics with the message.
+	Panic(args ...any)
+	// Panicf logs a message at level Panic then panics with the message.
+	Panicf(format string, args ...any)
 }
 
 // toLogLevel converts to LogLevel.
 func toLogLevel(level string) LogLevel {
 	switch strings.ToLower(level) {
+	case "trace":
+		return TraceLevel
 	case "debug":
 		return DebugLevel
 	case "info":
@@ -127,20 +371,34 @@ func toLogLevel(level string) LogLevel {
 		return ErrorLevel
 	case "fatal":
 		return FatalLevel
+	case "panic":
+		return PanicLevel
 	}
 
 	// unsupported log level by Dapr
 	return UndefinedLevel
 }
 
-// NewLogger creates new Logger instance.
-func NewLogger(name string) Logger {
+// NewLogger creates new Logger instance, configured with opts before it is
+// registered, so ApplyOptionsToLoggers can't observe it half-configured.
+// The options are ignored if a logger with the name is already registered,
+// which is returned as is. A logger with a dot-separated name, such as
+// runtime.actors.placement, inherits the output level, output and format of
+// its nearest registered ancestor, such as runtime.actors, before opts.
+func NewLogger(name string, opts ...Option) Logger {
 	globalLoggersLock.Lock()
 	defer globalLoggersLock.Unlock()
 
 	logger, ok := globalLoggers[name]
 	if !ok {
-		logger = newDaprLogger(name)
+		dl := newDaprLogger(name)
+		if parent := nearestAncestorLocked(name); parent != nil {
+			dl.inherit(parent)
+		}
+		for _, opt := range opts {
+			opt(dl)
+		}
+		logger = dl
 		globalLoggers[name] = logger
 	}
 
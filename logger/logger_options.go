@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"io"
+	"maps"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Option configures a logger created with NewLogger.
+type Option func(l *daprLogger)
+
+// WithJSON enables the JSON formatted output, see Logger.EnableJSONOutput.
+func WithJSON(enabled bool) Option {
+	return func(l *daprLogger) {
+		l.EnableJSONOutput(enabled)
+	}
+}
+
+// WithLevel sets the output level, see Logger.SetOutputLevel.
+func WithLevel(level LogLevel) Option {
+	return func(l *daprLogger) {
+		l.SetOutputLevel(level)
+	}
+}
+
+// WithAppID sets the app_id field, see Logger.SetAppID.
+func WithAppID(id string) Option {
+	return WithFields(map[string]any{logFieldAppID: id})
+}
+
+// WithOutput sets the destination of the logs, see Logger.SetOutput.
+func WithOutput(w io.Writer) Option {
+	return func(l *daprLogger) {
+		l.SetOutput(w)
+	}
+}
+
+// WithFields adds fields to all the entries of the logger. Unlike the fields
+// added with Logger.WithFields, they are kept when the output format changes.
+func WithFields(fields map[string]any) Option {
+	return func(l *daprLogger) {
+		if l.core.baseFields == nil {
+			l.core.baseFields = make(logrus.Fields, len(fields))
+		}
+		maps.Copy(l.core.baseFields, fields)
+		l.logger = l.logger.WithFields(fields)
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLoggerOptions(t *testing.T) {
+	var buf bytes.Buffer
+	testLogger := NewLogger("testLoggerOptions",
+		WithOutput(&buf),
+		WithFields(map[string]any{"region": "eu-west-1"}),
+		WithAppID("dapr-app"),
+		WithJSON(true),
+		WithLevel(DebugLevel),
+	)
+
+	assert.True(t, testLogger.IsOutputLevelEnabled(DebugLevel))
+	testLogger.Debug("configured")
+
+	o := readTestEntry(t, &buf)
+	assert.Equal(t, "configured", o[logFieldMessage])
+	assert.Equal(t, "eu-west-1", o["region"])
+	assert.Equal(t, "dapr-app", o[logFieldAppID])
+
+	t.Run("the fields are kept when the format changes", func(t *testing.T) {
+		buf.Reset()
+		testLogger.EnableJSONOutput(false)
+		testLogger.Info("text")
+
+		assert.Contains(t, buf.String(), "region=eu-west-1")
+		assert.Contains(t, buf.String(), "app_id=dapr-app")
+	})
+
+	t.Run("SetAppID replaces the app ID of the options", func(t *testing.T) {
+		testLogger.SetAppID("other-app")
+		t.Cleanup(func() { testLogger.SetAppID("dapr-app") })
+
+		buf.Reset()
+		testLogger.EnableJSONOutput(true)
+		testLogger.Info("json")
+
+		o = readTestEntry(t, &buf)
+		assert.Equal(t, "other-app", o[logFieldAppID])
+		assert.Equal(t, "eu-west-1", o["region"])
+	})
+
+	t.Run("the options of a registered logger are ignored", func(t *testing.T) {
+		same := NewLogger("testLoggerOptions", WithLevel(ErrorLevel))
+		assert.Same(t, testLogger, same)
+		assert.True(t, same.IsOutputLevelEnabled(DebugLevel))
+	})
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package loggertest provides a logger recording its entries in memory, to
+// assert on them in tests.
+package loggertest
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/dapr/kit/logger"
+)
+
+// loggerID makes the names of the loggers unique.
+var loggerID atomic.Uint64
+
+// Recorder holds the entries written by a logger returned by New.
+type Recorder struct {
+	lock    sync.Mutex
+	records []logger.Record
+}
+
+// New returns a logger recording all its entries, at any level, in the
+// returned Recorder instead of writing them out. The logger is registered with
+// a name unique to t, and stops recording when t ends.
+func New(t testing.TB) (logger.Logger, *Recorder) {
+	t.Helper()
+
+	r := &Recorder{}
+
+	l := logger.NewLogger(fmt.Sprintf("loggertest.%s.%d", t.Name(), loggerID.Add(1)))
+	l.SetOutputLevel(logger.TraceLevel)
+	l.SetWriteFunc(r.record)
+	t.Cleanup(func() {
+		l.SetWriteFunc(func(logger.Entry) error { return nil })
+	})
+
+	return l, r
+}
+
+func (r *Recorder) record(e logger.Entry) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.records = append(r.records, e)
+
+	return nil
+}
+
+// Records returns the recorded entries, in the order they were written.
+func (r *Recorder) Records() []logger.Record {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return slices.Clone(r.records)
+}
+
+// Reset discards the recorded entries.
+func (r *Recorder) Reset() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.records = nil
+}
+
+// HasEntry returns true if an entry was recorded at level with a message
+// containing msgSubstring.
+func (r *Recorder) HasEntry(level logger.LogLevel, msgSubstring string) bool {
+	return len(r.find(level, msgSubstring)) > 0
+}
+
+// FieldEquals returns true if an entry was recorded at level with a message
+// containing msgSubstring and the field key equal to value.
+func (r *Recorder) FieldEquals(level logger.LogLevel, msgSubstring string, key string, value any) bool {
+	for _, e := range r.find(level, msgSubstring) {
+		if v, ok := e.Fields[key]; ok && reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// find returns the entries recorded at level with a message containing
+// msgSubstring.
+func (r *Recorder) find(level logger.LogLevel, msgSubstring string) []logger.Record {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var res []logger.Record
+	for _, e := range r.records {
+		if e.Level == level && strings.Contains(e.Message, msgSubstring) {
+			res = append(res, e)
+		}
+	}
+
+	return res
+}
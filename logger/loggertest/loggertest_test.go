@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loggertest
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/kit/logger"
+)
+
+func TestNew(t *testing.T) {
+	log, rec := New(t)
+
+	log.Debug("debugging")
+	log.WithFields(map[string]any{"component": "statestore", "attempt": 2}).Errorf("failed to connect to %s", "redis")
+
+	records := rec.Records()
+	require.Len(t, records, 2)
+	assert.Equal(t, logger.DebugLevel, records[0].Level)
+	assert.Equal(t, "failed to connect to redis", records[1].Message)
+
+	assert.True(t, rec.HasEntry(logger.ErrorLevel, "failed to connect"))
+	assert.False(t, rec.HasEntry(logger.WarnLevel, "failed to connect"))
+	assert.False(t, rec.HasEntry(logger.ErrorLevel, "timed out"))
+
+	assert.True(t, rec.FieldEquals(logger.ErrorLevel, "failed", "component", "statestore"))
+	assert.True(t, rec.FieldEquals(logger.ErrorLevel, "failed", "attempt", 2))
+	assert.False(t, rec.FieldEquals(logger.ErrorLevel, "failed", "component", "pubsub"))
+	assert.False(t, rec.FieldEquals(logger.ErrorLevel, "failed", "missing", nil))
+
+	rec.Reset()
+	assert.Empty(t, rec.Records())
+}
+
+func TestNewIsolated(t *testing.T) {
+	log1, rec1 := New(t)
+	log2, rec2 := New(t)
+
+	log1.Info("one")
+	log2.Info("two")
+
+	assert.True(t, rec1.HasEntry(logger.InfoLevel, "one"))
+	assert.False(t, rec1.HasEntry(logger.InfoLevel, "two"))
+	assert.True(t, rec2.HasEntry(logger.InfoLevel, "two"))
+}
+
+func TestNewConcurrent(t *testing.T) {
+	log, rec := New(t)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			log.Info("concurrent")
+		})
+	}
+	wg.Wait()
+
+	assert.Len(t, rec.Records(), 10)
+}
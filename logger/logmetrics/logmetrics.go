@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package logmetrics provides the Prometheus counters of the log records
+// written, to alert on error spikes without parsing the output.
+package logmetrics
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/dapr/kit/logger"
+)
+
+const (
+	labelScope = "scope"
+	labelLevel = "level"
+)
+
+// Metrics counts the records written by the loggers it is attached to, per
+// scope and level, and the writes dropped by the async buffers, such as the one
+// of logger.Options.AsyncBufferSize, since the process started:
+//
+//	dapr_log_records_total{scope="<name>",level="error"}
+//	dapr_log_async_dropped_total
+type Metrics struct {
+	records *prometheus.CounterVec
+}
+
+// Register registers the counters with registerer and attaches them to all the
+// loggers created with logger.NewLogger so far. The loggers created later are
+// counted once passed to Attach.
+func Register(registerer prometheus.Registerer) (*Metrics, error) {
+	records := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "dapr",
+		Subsystem: "log",
+		Name:      "records_total",
+		Help:      "The number of log records written, per scope and level.",
+	}, []string{labelScope, labelLevel})
+	dropped := prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: "dapr",
+		Subsystem: "log",
+		Name:      "async_dropped_total",
+		Help:      "The number of log writes dropped because the async buffer was full.",
+	}, func() float64 {
+		return float64(logger.AsyncDroppedTotal())
+	})
+
+	if err := registerer.Register(records); err != nil {
+		return nil, fmt.Errorf("failed to register log records metric: %w", err)
+	}
+	if err := registerer.Register(dropped); err != nil {
+		registerer.Unregister(records)
+		return nil, fmt.Errorf("failed to register log dropped writes metric: %w", err)
+	}
+
+	m := &Metrics{records: records}
+	for _, info := range logger.List() {
+		if l, ok := logger.Get(info.Name); ok {
+			m.Attach(l)
+		}
+	}
+
+	return m, nil
+}
+
+// Attach counts the records written by l, such as a logger created after
+// Register. A logger must be attached only once.
+func (m *Metrics) Attach(l logger.Logger) {
+	l.AddHook(nil, func(r logger.Record) {
+		m.records.WithLabelValues(r.Scope, string(r.Level)).Inc()
+	})
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logmetrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/kit/logger"
+	"github.com/dapr/kit/logger/loggertest"
+)
+
+func TestRegister(t *testing.T) {
+	existing, _ := loggertest.New(t)
+	existing.SetOutputLevel(logger.InfoLevel)
+
+	reg := prometheus.NewPedanticRegistry()
+	m, err := Register(reg)
+	require.NoError(t, err)
+	_, err = Register(reg)
+	require.Error(t, err)
+
+	later, _ := loggertest.New(t)
+	m.Attach(later)
+
+	existing.Info("info")
+	existing.Error("first error")
+	existing.WithFields(map[string]any{"key": "value"}).Error("second error")
+	// Disabled levels aren't counted.
+	existing.Debug("debug")
+	later.Warn("warning")
+
+	scope := func(l logger.Logger) string {
+		for _, info := range logger.List() {
+			if got, _ := logger.Get(info.Name); got == l {
+				return info.Name
+			}
+		}
+		return ""
+	}
+
+	assert.InDelta(t, 1, testutil.ToFloat64(m.records.WithLabelValues(scope(existing), string(logger.InfoLevel))), 0)
+	assert.InDelta(t, 2, testutil.ToFloat64(m.records.WithLabelValues(scope(existing), string(logger.ErrorLevel))), 0)
+	assert.InDelta(t, 0, testutil.ToFloat64(m.records.WithLabelValues(scope(existing), string(logger.DebugLevel))), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(m.records.WithLabelValues(scope(later), string(logger.WarnLevel))), 0)
+
+	n, err := testutil.GatherAndCount(reg, "dapr_log_records_total", "dapr_log_async_dropped_total")
+	require.NoError(t, err)
+	assert.Equal(t, 5, n)
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+)
+
+const logFieldLogrName = "logger"
+
+// logrSink is a logr.LogSink writing the logs with a Logger.
+type logrSink struct {
+	log  Logger
+	name string
+}
+
+// NewLogr returns a logr.Logger writing the logs with log, for code based on
+// controller-runtime or client-go. V(0) logs are written at level Info, V(1)
+// at level Debug and the more verbose ones at level Trace. The key/value pairs
+// become entry fields, and the names added with WithName the logger field,
+// joined with "/".
+func NewLogr(log Logger) logr.Logger {
+	return logr.New(&logrSink{log: log})
+}
+
+func (s *logrSink) Init(logr.RuntimeInfo) {}
+
+func (s *logrSink) Enabled(level int) bool {
+	return s.log.IsOutputLevelEnabled(fromLogrLevel(level))
+}
+
+func (s *logrSink) Info(level int, msg string, keysAndValues ...any) {
+	log := s.log.WithFields(logrFields(keysAndValues))
+	switch fromLogrLevel(level) {
+	case InfoLevel:
+		log.Info(msg)
+	case DebugLevel:
+		log.Debug(msg)
+	default:
+		log.Trace(msg)
+	}
+}
+
+func (s *logrSink) Error(err error, msg string, keysAndValues ...any) {
+	log := s.log.WithFields(logrFields(keysAndValues))
+	if err != nil {
+		log = log.WithError(err)
+	}
+	log.Error(msg)
+}
+
+func (s *logrSink) WithValues(keysAndValues ...any) logr.LogSink {
+	return &logrSink{
+		log:  s.log.WithFields(logrFields(keysAndValues)),
+		name: s.name,
+	}
+}
+
+func (s *logrSink) WithName(name string) logr.LogSink {
+	if s.name != "" {
+		name = s.name + "/" + name
+	}
+
+	return &logrSink{
+		log:  s.log.WithFields(map[string]any{logFieldLogrName: name}),
+		name: name,
+	}
+}
+
+// fromLogrLevel converts a logr verbosity level to a LogLevel.
+func fromLogrLevel(level int) LogLevel {
+	switch {
+	case level <= 0:
+		return InfoLevel
+	case level == 1:
+		return DebugLevel
+	default:
+		return TraceLevel
+	}
+}
+
+// logrFields returns the logr key/value pairs as fields. A key without value
+// gets "<no-value>", as with the logr reference implementation.
+func logrFields(keysAndValues []any) map[string]any {
+	fields := make(map[string]any, (len(keysAndValues)+1)/2)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		k, ok := keysAndValues[i].(string)
+		if !ok {
+			k = fmt.Sprint(keysAndValues[i])
+		}
+
+		if i+1 < len(keysAndValues) {
+			fields[k] = keysAndValues[i+1]
+		} else {
+			fields[k] = "<no-value>"
+		}
+	}
+
+	return fields
+}
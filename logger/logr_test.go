@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLogr(t *testing.T) {
+	var buf bytes.Buffer
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	log := NewLogr(testLogger)
+
+	t.Run("info with values and names", func(t *testing.T) {
+		o := loggedTestEntry(t, &buf, func() {
+			log.WithName("controller").WithName("pod").
+				WithValues("namespace", "default").
+				Info("Reconciling", "name", "web-0", "dangling")
+		})
+
+		assert.Equal(t, "info", o[logFieldLevel])
+		assert.Equal(t, "Reconciling", o[logFieldMessage])
+		assert.Equal(t, "controller/pod", o[logFieldLogrName])
+		assert.Equal(t, "default", o["namespace"])
+		assert.Equal(t, "web-0", o["name"])
+		assert.Equal(t, "<no-value>", o["dangling"])
+	})
+
+	t.Run("v-levels", func(t *testing.T) {
+		assert.True(t, log.V(0).Enabled())
+		assert.False(t, log.V(1).Enabled())
+
+		testLogger.SetOutputLevel(TraceLevel)
+		defer testLogger.SetOutputLevel(InfoLevel)
+
+		o := loggedTestEntry(t, &buf, func() { log.V(1).Info("debug") })
+		assert.Equal(t, "debug", o[logFieldLevel])
+
+		o = loggedTestEntry(t, &buf, func() { log.V(4).Info("trace") })
+		assert.Equal(t, "trace", o[logFieldLevel])
+	})
+
+	t.Run("error", func(t *testing.T) {
+		o := loggedTestEntry(t, &buf, func() { log.Error(errors.New("boom"), "Reconcile failed") })
+		assert.Equal(t, "error", o[logFieldLevel])
+		assert.Equal(t, "boom", o[logFieldError])
+	})
+
+	t.Run("caller is the code calling the logr logger", func(t *testing.T) {
+		testLogger.EnableCaller(true)
+		defer testLogger.EnableCaller(false)
+
+		o := loggedTestEntry(t, &buf, func() { log.Info("with caller") })
+		assert.Contains(t, o[logFieldCaller], "logr_test.go:")
+
+		o = loggedTestEntry(t, &buf, func() { log.Error(errors.New("boom"), "with caller") })
+		assert.Contains(t, o[logFieldCaller], "logr_test.go:")
+	})
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	logFieldSampledCount = "sampled_count"
+
+	// DefaultSamplingInterval is the interval of SamplingOptions if not set.
+	DefaultSamplingInterval = time.Second
+
+	// messageSamplerMaxKeys is the number of messages tracked before the ones
+	// not seen in the last interval are forgotten.
+	messageSamplerMaxKeys = 4096
+)
+
+// SamplingOptions configures the sampling of repeated messages: in each
+// Interval, the first Initial entries with the same level and message are
+// written, then one in every Thereafter of them. A Thereafter of zero drops
+// all the entries after the Initial ones.
+type SamplingOptions struct {
+	// Initial is the number of entries with the same message written per interval
+	Initial int
+	// Thereafter is the 1-in-M rate the entries are written at after Initial
+	Thereafter int
+	// Interval is the window the entries are counted in.
+	// Defaults to DefaultSamplingInterval.
+	Interval time.Duration
+}
+
+// enabled returns true if opts sample the entries.
+func (opts SamplingOptions) enabled() bool {
+	return opts.Initial > 0 || opts.Thereafter > 0
+}
+
+type messageSampleKey struct {
+	level logrus.Level
+	msg   string
+}
+
+type messageSampleCounter struct {
+	windowStart time.Time
+	count       int
+	dropped     uint64
+}
+
+// messageSampler counts the entries per level and message.
+type messageSampler struct {
+	opts     SamplingOptions
+	lock     sync.Mutex
+	counters map[messageSampleKey]*messageSampleCounter
+}
+
+func newMessageSampler(opts SamplingOptions) *messageSampler {
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultSamplingInterval
+	}
+
+	return &messageSampler{
+		opts:     opts,
+		counters: make(map[messageSampleKey]*messageSampleCounter),
+	}
+}
+
+// sample returns true if the entry is written, with the number of entries
+// with the same message dropped since the last one written.
+func (s *messageSampler) sample(level logrus.Level, msg string, now time.Time) (bool, uint64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := messageSampleKey{level: level, msg: msg}
+	c, ok := s.counters[key]
+	if !ok {
+		if len(s.counters) >= messageSamplerMaxKeys {
+			s.pruneLocked(now)
+		}
+		c = &messageSampleCounter{windowStart: now}
+		s.counters[key] = c
+	}
+
+	if now.Sub(c.windowStart) >= s.opts.Interval {
+		c.windowStart = now
+		c.count = 0
+	}
+	c.count++
+
+	after := c.count - s.opts.Initial
+	if after <= 0 || (s.opts.Thereafter > 0 && after%s.opts.Thereafter == 0) {
+		dropped := c.dropped
+		c.dropped = 0
+		return true, dropped
+	}
+
+	c.dropped++
+
+	return false, 0
+}
+
+// pruneLocked forgets the messages not seen in the last interval.
+func (s *messageSampler) pruneLocked(now time.Time) {
+	for k, c := range s.counters {
+		if now.Sub(c.windowStart) >= s.opts.Interval {
+			delete(s.counters, k)
+		}
+	}
+}
+
+// SetMessageSampling samples the repeated entries with the same level and
+// message according to opts, so a failing component logging the same error in
+// a loop doesn't flood the output. Fatal entries are never sampled.
+// The first entry written after some were dropped carries their number in
+// the sampled_count field. Options not sampling any entry disable it.
+func (l *daprLogger) SetMessageSampling(opts SamplingOptions) {
+	if !opts.enabled() {
+		l.core.messageSampler.Store(nil)
+		return
+	}
+
+	l.core.messageSampler.Store(newMessageSampler(opts))
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestSetMessageSampling(t *testing.T) {
+	var buf bytes.Buffer
+
+	clock := clocktesting.NewFakeClock(time.Now())
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.core.clock = clock
+
+	readEntries := func() []map[string]any {
+		var entries []map[string]any
+		for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+			if line == "" {
+				continue
+			}
+			var o map[string]any
+			require.NoError(t, json.Unmarshal([]byte(line), &o))
+			entries = append(entries, o)
+		}
+		buf.Reset()
+
+		return entries
+	}
+
+	testLogger.SetMessageSampling(SamplingOptions{Initial: 2, Thereafter: 3, Interval: time.Second})
+
+	t.Run("initial then 1 in M", func(t *testing.T) {
+		for range 8 {
+			testLogger.Error("connection refused")
+		}
+
+		entries := readEntries()
+		// Entries 1, 2, 5 and 8 are written.
+		require.Len(t, entries, 4)
+		assert.NotContains(t, entries[0], logFieldSampledCount)
+		assert.NotContains(t, entries[1], logFieldSampledCount)
+		assert.InDelta(t, 2, entries[2][logFieldSampledCount], 0)
+		assert.InDelta(t, 2, entries[3][logFieldSampledCount], 0)
+	})
+
+	t.Run("messages and levels are counted apart", func(t *testing.T) {
+		testLogger.Error("other message")
+		testLogger.Warn("connection refused")
+
+		assert.Len(t, readEntries(), 2)
+	})
+
+	t.Run("counts reset every interval", func(t *testing.T) {
+		testLogger.Error("connection refused")
+		assert.Empty(t, readEntries())
+
+		clock.Step(time.Second)
+
+		testLogger.Error("connection refused")
+		testLogger.Error("connection refused")
+		testLogger.Error("connection refused")
+
+		entries := readEntries()
+		require.Len(t, entries, 2)
+		assert.InDelta(t, 1, entries[0][logFieldSampledCount], 0)
+		assert.NotContains(t, entries[1], logFieldSampledCount)
+	})
+
+	t.Run("no Thereafter drops after Initial", func(t *testing.T) {
+		testLogger.SetMessageSampling(SamplingOptions{Initial: 1})
+
+		for range 5 {
+			testLogger.Info("tick")
+		}
+
+		assert.Len(t, readEntries(), 1)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		testLogger.SetMessageSampling(SamplingOptions{})
+
+		for range 5 {
+			testLogger.Info("tick")
+		}
+
+		assert.Len(t, readEntries(), 5)
+	})
+}
+
+func TestMessageSamplerPrune(t *testing.T) {
+	now := time.Now()
+	s := newMessageSampler(SamplingOptions{Initial: 1})
+
+	for i := range messageSamplerMaxKeys {
+		s.sample(logrus.InfoLevel, strconv.Itoa(i), now)
+	}
+	require.Len(t, s.counters, messageSamplerMaxKeys)
+
+	ok, _ := s.sample(logrus.InfoLevel, "new", now.Add(DefaultSamplingInterval))
+	assert.True(t, ok)
+	assert.Len(t, s.counters, 1)
+}
@@ -16,7 +16,14 @@ limitations under the License.
 package logger
 
 import (
+	"context"
+	"fmt"
 	"io"
+	"log"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	kclock "k8s.io/utils/clock"
 )
 
 type nopLogger struct{}
@@ -24,18 +31,78 @@ type nopLogger struct{}
 // EnableJSONOutput enables JSON formatted output log.
 func (n *nopLogger) EnableJSONOutput(_ bool) {}
 
+// SetJSONFormat sets the layout of the JSON output.
+func (n *nopLogger) SetJSONFormat(_ JSONFormat) {}
+
+// SetOutputEncoding sets the encoding of the output.
+func (n *nopLogger) SetOutputEncoding(_ OutputEncoding) {}
+
 // SetAppID sets dapr_id field in the log. nopLogger value is empty string.
 func (n *nopLogger) SetAppID(_ string) {}
 
+// SetComponentVersion sets component_version field in the log.
+func (n *nopLogger) SetComponentVersion(_ string) {}
+
+// SetSchemaVersion sets schema_version field in the log.
+func (n *nopLogger) SetSchemaVersion(_ string) {}
+
 // SetOutputLevel sets log output level.
 func (n *nopLogger) SetOutputLevel(_ LogLevel) {}
 
+// SetOutputForLevels sets the destination of the entries at the given levels.
+func (n *nopLogger) SetOutputForLevels(_ []LogLevel, _ io.Writer) {}
+
+// SetWriteFunc sets the function writing the entries.
+func (n *nopLogger) SetWriteFunc(_ func(Entry) error) {}
+
+// SetEnabledLevels sets the exact levels to output.
+func (n *nopLogger) SetEnabledLevels(_ ...LogLevel) {}
+
+// AddSink adds a destination receiving the entries.
+func (n *nopLogger) AddSink(_ io.Writer, _ SinkOptions) {}
+
 // SetOutput sets the destination for the logs
 func (n *nopLogger) SetOutput(_ io.Writer) {}
 
 // IsOutputLevelEnabled returns true if the logger will output this LogLevel.
 func (n *nopLogger) IsOutputLevelEnabled(_ LogLevel) bool { return true }
 
+// SetFieldKeyCase sets the case custom field keys are converted to.
+func (n *nopLogger) SetFieldKeyCase(_ FieldKeyCase) {}
+
+// SetSizeLimits sets the maximum size of the message and string fields.
+func (n *nopLogger) SetSizeLimits(_, _ int) {}
+
+// SetFieldFilter sets the fields dropped from the entries.
+func (n *nopLogger) SetFieldFilter(_, _ []string) {}
+
+// SetFloatPrecision sets the decimal places float fields are rounded to.
+func (n *nopLogger) SetFloatPrecision(_ int) {}
+
+// EnableStackTrace adds the stacktrace field.
+func (n *nopLogger) EnableStackTrace(_ bool) {}
+
+// EnableStructuredErrors adds the error.causes field.
+func (n *nopLogger) EnableStructuredErrors(_ bool) {}
+
+// EnableCaller adds the caller and func fields.
+func (n *nopLogger) EnableCaller(_ bool) {}
+
+// ForceColors enables the colorized text output.
+func (n *nopLogger) ForceColors(_ bool) {}
+
+// SetTimestampFormat sets the layout of the time field.
+func (n *nopLogger) SetTimestampFormat(_ string, _ bool) {}
+
+// SetCompactEnvelope moves the envelope fields into the m field.
+func (n *nopLogger) SetCompactEnvelope(_ bool) {}
+
+// SetFrameHeader sets the header prepended to each formatted entry.
+func (n *nopLogger) SetFrameHeader(_ FrameHeader) {}
+
+// SetPartitioning adds to each entry a partition field.
+func (n *nopLogger) SetPartitioning(_ string, _ int) {}
+
 // WithLogType specify the log_type field in log. nopLogger value is LogTypeLog.
 func (n *nopLogger) WithLogType(_ string) Logger {
 	return n
@@ -46,12 +113,234 @@ func (n *nopLogger) WithFields(_ map[string]any) Logger {
 	return n
 }
 
+// Child returns the logger of a descendant scope.
+func (n *nopLogger) Child(_ string) Logger {
+	return n
+}
+
+// WithError returns a logger with the error fields.
+func (n *nopLogger) WithError(_ error) Logger {
+	return n
+}
+
+// WithOrigin returns a logger with the origin field.
+func (n *nopLogger) WithOrigin(_ string) Logger {
+	return n
+}
+
+// Relay re-emits an entry.
+func (n *nopLogger) Relay(_ Entry) {}
+
+// WithVersionConflict returns a logger with the versions of a conflict.
+func (n *nopLogger) WithVersionConflict(_ string, _, _ int64) Logger {
+	return n
+}
+
+// WithBinding returns a logger with the binding fields.
+func (n *nopLogger) WithBinding(_, _ string) Logger {
+	return n
+}
+
+// LogBindingResult logs the outcome of a binding invocation.
+func (n *nopLogger) LogBindingResult(_ bool, _ error, _ time.Duration) {}
+
+// WithTokenBucket returns a logger with the state of a token bucket rate limiter.
+func (n *nopLogger) WithTokenBucket(_ string, _, _ float64, _ float64) Logger {
+	return n
+}
+
+// WithStream returns a logger with the stream fields.
+func (n *nopLogger) WithStream(_ string, _ string) Logger {
+	return n
+}
+
+// LogStreamEvent logs a lifecycle event of a stream.
+func (n *nopLogger) LogStreamEvent(_ string, _ int) {}
+
+// WithCompressedField returns a logger with data compressed in the key field.
+func (n *nopLogger) WithCompressedField(_ string, _ []byte) Logger {
+	return n
+}
+
+// DefineChannel defines a named channel.
+func (n *nopLogger) DefineChannel(_ string, _ io.Writer, _ LogLevel) {}
+
+// ToChannel returns a logger bound to the named channel.
+func (n *nopLogger) ToChannel(_ string) Logger {
+	return n
+}
+
+// WithContext returns a logger carrying the fields found in ctx.
+func (n *nopLogger) WithContext(_ context.Context) Logger {
+	return n
+}
+
+// WithSpanLink returns a logger carrying the fields of the linked span.
+func (n *nopLogger) WithSpanLink(_ trace.SpanContext) Logger {
+	return n
+}
+
+// WithPayloadSnippet returns a logger with a snippet of payload.
+func (n *nopLogger) WithPayloadSnippet(_ []byte, _ int) Logger {
+	return n
+}
+
+// LogSerdeError logs a failure to encode or decode a payload.
+func (n *nopLogger) LogSerdeError(_ string, _ string, _ error, _ int) {}
+
+// EnableDegradationTracking marks error entries with degraded=true when the error rate is high.
+func (n *nopLogger) EnableDegradationTracking(_ time.Duration, _ float64) {}
+
+// SuppressRepeats drops identical consecutive entries.
+func (n *nopLogger) SuppressRepeats(_ time.Duration) {}
+
+// EnableCoalescing collapses identical consecutive entries.
+func (n *nopLogger) EnableCoalescing(_ time.Duration) {}
+
+// SetSampling keeps at random a share of the entries.
+func (n *nopLogger) SetSampling(_ float64) {}
+
+// SetSamplerSeed seeds the random generator of the sampling.
+func (n *nopLogger) SetSamplerSeed(_ int64) {}
+
+// SetMessageSampling samples the repeated entries.
+func (n *nopLogger) SetMessageSampling(_ SamplingOptions) {}
+
+// LogLeaderElection logs the leader election state of a candidate.
+func (n *nopLogger) LogLeaderElection(_ string, _ bool, _ int) {}
+
+// LogLeadershipTransition logs that a candidate gained or lost leadership.
+func (n *nopLogger) LogLeadershipTransition(_ string, _ bool, _ int) {}
+
+// Instrument runs fn, returning the error returned by fn.
+func (n *nopLogger) Instrument(_ string, fn func() error) error {
+	return fn()
+}
+
+// LogReadiness logs the readiness state of a component.
+func (n *nopLogger) LogReadiness(_ string, _ bool, _ string) {}
+
+// LogEviction logs a cache eviction.
+func (n *nopLogger) LogEviction(_, _, _ string) {}
+
+// WithSensitivity returns a logger with the sensitivity classification.
+func (n *nopLogger) WithSensitivity(_ SensitivityLevel) Logger {
+	return n
+}
+
+// SetAuditedSink sets the destination of the Restricted entries.
+func (n *nopLogger) SetAuditedSink(_ io.Writer) {}
+
+// SetAuditWriter sets the destination of the audit entries.
+func (n *nopLogger) SetAuditWriter(_ io.Writer) {}
+
+// WithBackoff returns a logger with the retry backoff.
+func (n *nopLogger) WithBackoff(_ int, _, _ time.Duration) Logger {
+	return n
+}
+
+// LogLimitApproaching logs that a resource is close to its limit.
+func (n *nopLogger) LogLimitApproaching(_ string, _, _ int64, _ float64) {}
+
+// WithTenant returns a logger with the tenant_id field.
+func (n *nopLogger) WithTenant(_ string) Logger {
+	return n
+}
+
+// SetTenantRouting sets the destination of the entries of a tenant.
+func (n *nopLogger) SetTenantRouting(_ func(tenantID string) io.Writer) {}
+
+// LogCertExpiry logs the expiry of a certificate.
+func (n *nopLogger) LogCertExpiry(_ string, _ time.Time, _ time.Duration) {}
+
+// EnableEntryRetention keeps the last entries written.
+func (n *nopLogger) EnableEntryRetention(_ int) {}
+
+// WriteSupportBundle writes the support bundle.
+func (n *nopLogger) WriteSupportBundle(_ string) error { return nil }
+
+// LogWindowRollover logs the rollover of a rate limiter window.
+func (n *nopLogger) LogWindowRollover(_ string, _, _ time.Time, _, _ int64) {}
+
+// LogIdempotencyCollision logs an idempotency key collision.
+func (n *nopLogger) LogIdempotencyCollision(_ string, _ time.Time) {}
+
+// LogRebalance logs a partition rebalance.
+func (n *nopLogger) LogRebalance(_ string, _, _ []int) {}
+
+// WithConnectionRetry returns a logger for a connection attempt.
+func (n *nopLogger) WithConnectionRetry(_ string, _ int) Logger {
+	return n
+}
+
+// LogConnectionStats logs the connection stats of a target.
+func (n *nopLogger) LogConnectionStats(_ string) {}
+
+// SetQueueHighWaterMark sets the queue high-water mark.
+func (n *nopLogger) SetQueueHighWaterMark(_ float64) {}
+
+// LogQueueDepth logs the depth of a queue.
+func (n *nopLogger) LogQueueDepth(_ string, _, _ int) {}
+
+// LogSelfCheck logs the result of a self-check.
+func (n *nopLogger) LogSelfCheck(_ string, _ bool, _ map[string]any) {}
+
+// TrackFieldCardinality starts estimating the distinct values of a field.
+func (n *nopLogger) TrackFieldCardinality(_ string) {}
+
+// LogCardinalityWarning logs when a field has too many distinct values.
+func (n *nopLogger) LogCardinalityWarning(_ string, _ int) {}
+
+// LogDrain logs the progress of draining the connections of a listener.
+func (n *nopLogger) LogDrain(_ string, _, _ int, _ time.Time) {}
+
+// LogConfigReload logs the changes between two configurations.
+func (n *nopLogger) LogConfigReload(_, _ map[string]any) {}
+
+// OnWrite registers a callback invoked after each entry is written.
+func (n *nopLogger) OnWrite(_ func(e Entry, n int, err error)) {}
+
+// AddHook registers a hook invoked with each entry written.
+func (n *nopLogger) AddHook(_ []LogLevel, _ func(Record)) {}
+
+// NewEntryTimer returns a timer whose entries are discarded.
+func (n *nopLogger) NewEntryTimer() *EntryTimer {
+	return &EntryTimer{logger: n, clock: kclock.RealClock{}}
+}
+
+// LogDNSResolution logs the outcome of resolving host.
+func (n *nopLogger) LogDNSResolution(_ string, _ []string, _ time.Duration, _ error) {}
+
+// LogShutdownPhase logs the current graceful shutdown phase.
+func (n *nopLogger) LogShutdownPhase(_ string, _ time.Duration) {}
+
+// LogShutdownComplete logs the graceful shutdown completion.
+func (n *nopLogger) LogShutdownComplete(_ time.Duration) {}
+
+// LogValidation logs the outcome of validating a request.
+func (n *nopLogger) LogValidation(_ string, _ bool, _ []FieldError, _ time.Duration) {}
+
+// LogSchemaViolations logs the JSON Schema violations of a document.
+func (n *nopLogger) LogSchemaViolations(_ string, _ []SchemaViolation) {}
+
+// LogRuntimeStats logs a snapshot of the memory and GC stats.
+func (n *nopLogger) LogRuntimeStats() {}
+
 // Info logs a message at level Info.
 func (n *nopLogger) Info(_ ...any) {}
 
+// InfoWithNote logs a message at level Info annotated with a note.
+func (n *nopLogger) InfoWithNote(_, _ string) {}
+
 // Infof logs a message at level Info.
 func (n *nopLogger) Infof(_ string, _ ...any) {}
 
+// Trace logs a message at level Trace.
+func (n *nopLogger) Trace(_ ...any) {}
+
+// Tracef logs a message at level Trace.
+func (n *nopLogger) Tracef(_ string, _ ...any) {}
+
 // Debug logs a message at level Debug.
 func (n *nopLogger) Debug(_ ...any) {}
 
@@ -75,3 +364,24 @@ func (n *nopLogger) Fatal(_ ...any) {}
 
 // Fatalf logs a message at level Fatal then the process will exit with status set to 1.
 func (n *nopLogger) Fatalf(_ string, _ ...any) {}
+
+// Audit logs an audit entry.
+func (n *nopLogger) Audit(_ ...any) error { return nil }
+
+// Auditf logs an audit entry.
+func (n *nopLogger) Auditf(_ string, _ ...any) error { return nil }
+
+// StdLogger returns a *log.Logger discarding its writes.
+func (n *nopLogger) StdLogger(_ LogLevel) *log.Logger {
+	return log.New(io.Discard, "", 0)
+}
+
+// Panic logs a message at level Panic then panics with the message.
+func (n *nopLogger) Panic(args ...any) {
+	panic(fmt.Sprint(args...))
+}
+
+// Panicf logs a message at level Panic then panics with the message.
+func (n *nopLogger) Panicf(format string, args ...any) {
+	panic(fmt.Sprintf(format, args...))
+}
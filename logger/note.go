@@ -0,0 +1,40 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"strings"
+)
+
+const logFieldNote = "note"
+
+// noteReplacer keeps the note on a single line.
+var noteReplacer = strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ")
+
+// InfoWithNote logs a message at level Info annotated with a note.
+// The note is emitted as a note field in JSON and as a trailing "# note"
+// comment in text mode.
+func (l *daprLogger) InfoWithNote(msg, note string) {
+	l.WithFields(map[string]any{logFieldNote: note}).Info(msg)
+}
+
+// appendNote appends the note as a trailing comment to a formatted text line.
+func appendNote(line []byte, note string) []byte {
+	line = bytes.TrimRight(line, "\n")
+	line = append(line, " # "...)
+	line = append(line, noteReplacer.Replace(note)...)
+
+	return append(line, '\n')
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInfoWithNote(t *testing.T) {
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+
+		testLogger.InfoWithNote("sidecar started", "rolled out by ops")
+
+		o := readTestEntry(t, &buf)
+
+		assert.Equal(t, "sidecar started", o[logFieldMessage])
+		assert.Equal(t, "rolled out by ops", o[logFieldNote])
+	})
+
+	t.Run("text", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(false)
+
+		testLogger.InfoWithNote("sidecar started", "rolled out\nby ops")
+
+		b, _ := buf.ReadBytes('\n')
+
+		assert.True(t, regexp.MustCompile(`(^| )msg="sidecar started" .* # rolled out by ops\n$`).Match(b), string(b))
+		assert.False(t, regexp.MustCompile(`(^| )note=`).Match(b))
+	})
+
+	t.Run("disabled level", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.SetOutputLevel(WarnLevel)
+
+		testLogger.InfoWithNote("sidecar started", "rolled out by ops")
+
+		assert.Empty(t, buf.Bytes())
+	})
+}
@@ -0,0 +1,29 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"slices"
+)
+
+// OnWrite registers fn to be invoked after each entry is written, with the
+// entry, the number of bytes written and the write error, if any.
+// Callbacks are invoked outside of the logger lock, so they may log.
+func (l *daprLogger) OnWrite(fn func(e Entry, n int, err error)) {
+	l.core.lock.Lock()
+	defer l.core.lock.Unlock()
+
+	// Copy on write, so the writer can iterate the callbacks without the lock.
+	l.core.onWrite = append(slices.Clip(l.core.onWrite), fn)
+}
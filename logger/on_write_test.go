@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestOnWrite(t *testing.T) {
+	t.Run("successful write", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+
+		var (
+			entries []Entry
+			written []int
+		)
+		testLogger.OnWrite(func(e Entry, n int, err error) {
+			require.NoError(t, err)
+			entries = append(entries, e)
+			written = append(written, n)
+		})
+
+		testLogger.WithFields(map[string]any{"answer": 42}).Warn("first")
+		firstLen := buf.Len()
+		testLogger.Info("second")
+
+		require.Len(t, entries, 2)
+		assert.Equal(t, []int{firstLen, buf.Len() - firstLen}, written)
+
+		assert.Equal(t, "first", entries[0].Message)
+		assert.Equal(t, WarnLevel, entries[0].Level)
+		assert.Equal(t, fakeLoggerName, entries[0].Scope)
+		assert.Equal(t, LogTypeLog, entries[0].Type)
+		assert.Equal(t, 42, entries[0].Fields["answer"])
+		assert.False(t, entries[0].Time.IsZero())
+
+		assert.Equal(t, "second", entries[1].Message)
+		assert.NotContains(t, entries[1].Fields, "answer")
+	})
+
+	t.Run("failed write", func(t *testing.T) {
+		testLogger := getTestLogger(failingWriter{})
+
+		var called bool
+		testLogger.OnWrite(func(e Entry, n int, err error) {
+			called = true
+			assert.Equal(t, "lost", e.Message)
+			assert.Zero(t, n)
+			require.EqualError(t, err, "disk full")
+		})
+
+		testLogger.Error("lost")
+		assert.True(t, called)
+	})
+
+	t.Run("callbacks may log", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+
+		calls := 0
+		testLogger.OnWrite(func(e Entry, _ int, _ error) {
+			calls++
+			if e.Message == "outer" {
+				testLogger.Info("inner")
+			}
+		})
+
+		testLogger.Info("outer")
+		assert.Equal(t, 2, calls)
+	})
+}
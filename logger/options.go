@@ -15,12 +15,21 @@ package logger
 
 import (
 	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 const (
 	defaultJSONOutput  = false
 	defaultOutputLevel = "info"
 	undefinedAppID     = ""
+
+	outputTargetJournald = "journald"
+	outputTargetEventLog = "eventlog://"
 )
 
 // Options defines the sets of options for Dapr logging.
@@ -31,13 +40,136 @@ type Options struct {
 	// JSONFormatEnabled is the flag to enable JSON formatted log
 	JSONFormatEnabled bool
 
-	// OutputLevel is the level of logging
+	// JSONFormat is the layout of the JSON output, "ecs" for the Elastic
+	// Common Schema, "gcp" for Google Cloud Logging or "clef" for Seq. The
+	// Dapr layout is used if empty
+	JSONFormat string
+
+	// OutputEncoding is the encoding of the output, "cbor" for binary CBOR
+	// in place of the text or JSON lines, which can't be combined with
+	// OutputTarget. The lines are written if empty
+	OutputEncoding string
+
+	// OutputLevel is the level of logging, or the levels per logger with a
+	// spec such as "info,components.state:debug", see ApplyLevelSpec
 	OutputLevel string
+
+	// EnableCaller is the flag to add the file:line and function the
+	// entries are logged from
+	EnableCaller bool
+	// EnableStackTrace is the flag to add the stack of the caller of
+	// WithError to the entries
+	EnableStackTrace bool
+	// StructuredErrors is the flag to add the messages and types of the
+	// wrapped errors in the error.causes array, see EnableStructuredErrors
+	StructuredErrors bool
+
+	// MaxMessageBytes is the size the messages are truncated at, unlimited
+	// if 0
+	MaxMessageBytes int
+	// MaxFieldBytes is the size the string fields are truncated at,
+	// unlimited if 0
+	MaxFieldBytes int
+
+	// FieldAllowList are the patterns of the fields kept, all fields if
+	// empty, see SetFieldFilter
+	FieldAllowList []string
+	// FieldDenyList are the patterns of the fields dropped, see
+	// SetFieldFilter
+	FieldDenyList []string
+
+	// ForceColors is the flag to colorize the text output even if stdout
+	// isn't a terminal
+	ForceColors bool
+
+	// TimestampFormat is the layout of the time field, DefaultTimestampFormat
+	// if empty
+	TimestampFormat string
+	// UTC is the flag to write the time field in UTC instead of the local
+	// time zone
+	UTC bool
+
+	// Sampling samples the repeated entries with the same level and message.
+	// Disabled if neither Initial nor Thereafter is set.
+	Sampling SamplingOptions
+
+	// OutputFile is the file the logs are written to, rotated with
+	// MaxSizeMB, MaxAgeDays, MaxBackups and CompressBackups.
+	// The logs are written to the logger outputs if empty.
+	OutputFile string
+
+	// MaxSizeMB is the size in megabytes OutputFile is rotated at
+	MaxSizeMB int
+
+	// MaxAgeDays is the number of days the backups of OutputFile are kept
+	MaxAgeDays int
+
+	// MaxBackups is the number of backups of OutputFile kept
+	MaxBackups int
+
+	// CompressBackups is the flag to gzip-compress the backups of OutputFile
+	CompressBackups bool
+	// OutputTarget is the syslog server the logs are written to, such as
+	// syslog://host:514, journald for the systemd journal on Linux or
+	// eventlog://source for the Windows Event Log under source, in place of
+	// the logger outputs and OutputFile.
+	// See NewSyslogWriter for the accepted syslog targets.
+	OutputTarget string
+	// AsyncBufferSize is the number of writes buffered when writing the logs
+	// in the background, see NewAsyncWriter. The writes are synchronous if
+	// zero.
+	AsyncBufferSize int
+
+	// OTLPEndpoint is the OTLP/HTTP logs URL of the collector the logs are
+	// exported to, or its host:port with the grpc OTLPProtocol, in addition
+	// to the logger outputs. Disabled if empty.
+	OTLPEndpoint string
+	// OTLPProtocol is the transport of the OTLP export requests, http/json
+	// or grpc. Defaults to http/json.
+	OTLPProtocol string
+	// OTLPHeaders are added to each OTLP export request
+	OTLPHeaders map[string]string
+	// OTLPBatchSize is the number of records exported at once
+	OTLPBatchSize int
+	// OTLPFlushInterval is how often the pending records are exported
+	OTLPFlushInterval time.Duration
+
+	// Sinks are the destinations receiving the entries in addition to the
+	// output, see AddSink. Applying the options replaces the sinks of the
+	// options last applied.
+	Sinks []OptionsSink
+}
+
+// OptionsSink is a destination of Options.Sinks.
+type OptionsSink struct {
+	SinkOptions
+
+	// Output is "stdout", one of the targets of Options.OutputTarget, or
+	// else the path of a file
+	Output string
 }
 
-// SetOutputLevel sets the log output level.
+var (
+	// optionsOutput is the writer of the OutputFile last applied.
+	optionsOutput     io.Closer
+	optionsOutputLock sync.Mutex
+	// optionsAsync is the writer of the AsyncBufferSize last applied.
+	optionsAsync atomic.Pointer[AsyncWriter]
+
+	// optionsExporter is the exporter of the OTLPEndpoint last applied.
+	optionsExporter atomic.Pointer[OTLPExporter]
+	// optionsSinks are the writers of the Sinks last applied.
+	optionsSinks []io.Closer
+)
+
+// SetOutputLevel sets the log output level, or the levels per logger with a
+// spec such as "info,components.state:debug", see ApplyLevelSpec.
 func (o *Options) SetOutputLevel(outputLevel string) error {
-	if toLogLevel(outputLevel) == UndefinedLevel {
+	if isLevelSpec(outputLevel) {
+		if _, err := parseLevelSpec(outputLevel); err != nil {
+			return err
+		}
+	} else if toLogLevel(outputLevel) == UndefinedLevel {
 		return fmt.Errorf("undefined Log Output Level: %s", outputLevel)
 	}
 
@@ -61,7 +193,7 @@ func (o *Options) AttachCmdFlags(
 			&o.OutputLevel,
 			"log-level",
 			defaultOutputLevel,
-			"Options are debug, info, warn, error, or fatal (default info)")
+			"Options are trace, debug, info, warn, error, or fatal (default info)")
 	}
 
 	if boolVar != nil {
@@ -82,27 +214,308 @@ func DefaultOptions() Options {
 	}
 }
 
-// ApplyOptionsToLoggers applys options to all registered loggers.
+// ApplyOptionsToLoggers applys options to all registered loggers. The outputs
+// are reconciled with the options last applied: unsetting OutputFile,
+// OutputTarget or AsyncBufferSize reverts the loggers to stdout, and unsetting
+// OTLPEndpoint stops exporting the entries. If the options are invalid, or an
+// output fails to open, the loggers are left unchanged.
 func ApplyOptionsToLoggers(options *Options) error {
 	internalLoggers := getLoggers()
 
+	jsonFormat, ok := toJSONFormat(options.JSONFormat)
+	if !ok {
+		return fmt.Errorf("invalid value for JSONFormat: %s", options.JSONFormat)
+	}
+	outputEncoding, err := validateOptionsEncoding(options)
+	if err != nil {
+		return err
+	}
+	otlpProtocol, ok := toOTLPProtocol(options.OTLPProtocol)
+	if !ok {
+		return fmt.Errorf("invalid value for OTLPProtocol: %s", options.OTLPProtocol)
+	}
+	if err := validateFieldPatterns(options.FieldAllowList); err != nil {
+		return fmt.Errorf("invalid value for FieldAllowList: %w", err)
+	}
+	if err := validateFieldPatterns(options.FieldDenyList); err != nil {
+		return fmt.Errorf("invalid value for FieldDenyList: %w", err)
+	}
+
+	var spec levelSpec
+	if isLevelSpec(options.OutputLevel) {
+		if spec, err = parseLevelSpec(options.OutputLevel); err != nil {
+			return fmt.Errorf("invalid value for --log-level: %w", err)
+		}
+	} else {
+		spec.defaultLevel = toLogLevel(options.OutputLevel)
+		if spec.defaultLevel == UndefinedLevel {
+			return fmt.Errorf("invalid value for --log-level: %s", options.OutputLevel)
+		}
+	}
+
+	optionsOutputLock.Lock()
+	defer optionsOutputLock.Unlock()
+
+	// Open every writer before changing any logger, so that failing options
+	// leave the options last applied in place.
+	output, err := openOptionsOutput(options)
+	if err != nil {
+		return err
+	}
+
+	sinkWriters, err := openOptionsSinks(options.Sinks)
+	if err != nil {
+		output.close()
+		return err
+	}
+
+	var exp *OTLPExporter
+	if options.OTLPEndpoint != "" {
+		exp, err = NewOTLPExporter(OTLPExporterOptions{
+			Endpoint:      options.OTLPEndpoint,
+			Protocol:      otlpProtocol,
+			Headers:       options.OTLPHeaders,
+			BatchSize:     options.OTLPBatchSize,
+			FlushInterval: options.OTLPFlushInterval,
+		})
+		if err != nil {
+			output.close()
+			closeAll(sinkWriters)
+			return err
+		}
+	}
+
 	// Apply formatting options first
 	for _, v := range internalLoggers {
+		v.SetJSONFormat(jsonFormat)
 		v.EnableJSONOutput(options.JSONFormatEnabled)
+		v.SetOutputEncoding(outputEncoding)
+		v.EnableCaller(options.EnableCaller)
+		v.EnableStackTrace(options.EnableStackTrace)
+		v.EnableStructuredErrors(options.StructuredErrors)
+		v.SetTimestampFormat(options.TimestampFormat, options.UTC)
+		v.ForceColors(options.ForceColors)
+		v.SetSizeLimits(options.MaxMessageBytes, options.MaxFieldBytes)
+		v.SetFieldFilter(options.FieldAllowList, options.FieldDenyList)
+		v.SetMessageSampling(options.Sampling)
 
 		if options.appID != undefinedAppID {
 			v.SetAppID(options.appID)
 		}
 	}
 
-	daprLogLevel := toLogLevel(options.OutputLevel)
-	if daprLogLevel == UndefinedLevel {
-		return fmt.Errorf("invalid value for --log-level: %s", options.OutputLevel)
+	applyOptionsOutput(internalLoggers, output)
+
+	applyOptionsExporter(internalLoggers, exp)
+
+	applyOptionsSinks(internalLoggers, options.Sinks, sinkWriters)
+
+	for name, v := range internalLoggers {
+		if lvl := spec.levelFor(name); lvl != UndefinedLevel {
+			v.SetOutputLevel(lvl)
+		}
 	}
 
-	for _, v := range internalLoggers {
-		v.SetOutputLevel(daprLogLevel)
+	return nil
+}
+
+// applyOptionsExporter replaces the exporter of the loggers with exp, which
+// is nil if no OTLPEndpoint is set, and closes the previous one. The caller
+// must hold optionsOutputLock.
+func applyOptionsExporter(loggers map[string]Logger, exp *OTLPExporter) {
+	for _, v := range loggers {
+		if dl, ok := v.(*daprLogger); ok {
+			dl.core.exporter.Store(exp)
+		}
+	}
+
+	if prev := optionsExporter.Swap(exp); prev != nil {
+		prev.Close()
+	}
+}
+
+// optionsOutputState is the output opened for OutputTarget, OutputFile and
+// AsyncBufferSize.
+type optionsOutputState struct {
+	out    io.Writer
+	closer io.Closer
+	async  *AsyncWriter
+}
+
+// openOptionsOutput opens the output of options, or returns nil if options
+// set none, in which case the loggers write to stdout.
+func openOptionsOutput(options *Options) (*optionsOutputState, error) {
+	if options.OutputTarget == "" && options.OutputFile == "" && options.AsyncBufferSize <= 0 {
+		return nil, nil
+	}
+
+	w, err := optionsOutputWriter(options)
+	if err != nil {
+		return nil, err
 	}
 
+	if options.AsyncBufferSize <= 0 {
+		return &optionsOutputState{out: w, closer: w}, nil
+	}
+
+	async := NewAsyncWriter(w, options.AsyncBufferSize)
+
+	return &optionsOutputState{
+		out:    async,
+		closer: asyncCloser{async: async, w: w},
+		async:  async,
+	}, nil
+}
+
+// close closes the output, if not nil.
+func (s *optionsOutputState) close() {
+	if s != nil {
+		s.closer.Close()
+	}
+}
+
+// applyOptionsOutput sets the output of loggers to output, and closes the
+// output of the options last applied. If output is nil, the loggers are reset
+// to stdout if the options last applied set an output, and are left unchanged
+// otherwise. The caller must hold optionsOutputLock.
+func applyOptionsOutput(loggers map[string]Logger, output *optionsOutputState) {
+	if output == nil && optionsOutput == nil {
+		return
+	}
+
+	var (
+		out    io.Writer = os.Stdout
+		closer io.Closer
+		async  *AsyncWriter
+	)
+	if output != nil {
+		out, closer, async = output.out, output.closer, output.async
+	}
+
+	for _, v := range loggers {
+		v.SetOutput(out)
+	}
+
+	prev := optionsOutput
+	optionsOutput = closer
+	optionsAsync.Store(async)
+
+	if prev != nil {
+		prev.Close()
+	}
+}
+
+// optionsOutputWriter opens the output of OutputTarget, or else OutputFile,
+// or else returns os.Stdout.
+func optionsOutputWriter(options *Options) (io.WriteCloser, error) {
+	if options.OutputTarget == outputTargetJournald {
+		return NewJournaldWriter()
+	}
+
+	if source, ok := strings.CutPrefix(options.OutputTarget, outputTargetEventLog); ok {
+		return NewEventLogWriter(source)
+	}
+
+	if options.OutputTarget != "" {
+		if !isSyslogTarget(options.OutputTarget) {
+			return nil, fmt.Errorf("invalid value for OutputTarget: %s", options.OutputTarget)
+		}
+
+		return NewSyslogWriter(options.OutputTarget)
+	}
+
+	if options.OutputFile == "" {
+		return nopCloser{Writer: os.Stdout}, nil
+	}
+
+	return NewRotatingFileWriter(RotatingFileOptions{
+		Filename:   options.OutputFile,
+		MaxSizeMB:  options.MaxSizeMB,
+		MaxAge:     time.Duration(options.MaxAgeDays) * 24 * time.Hour,
+		MaxBackups: options.MaxBackups,
+		Compress:   options.CompressBackups,
+	})
+}
+
+// openOptionsSinks opens the writers of sinks.
+func openOptionsSinks(sinks []OptionsSink) ([]io.WriteCloser, error) {
+	writers := make([]io.WriteCloser, 0, len(sinks))
+	for _, s := range sinks {
+		var o Options
+		o.setOutput(s.Output)
+		w, err := optionsOutputWriter(&o)
+		if err != nil {
+			closeAll(writers)
+			return nil, fmt.Errorf("invalid sink output %s: %w", s.Output, err)
+		}
+		writers = append(writers, w)
+	}
+
+	return writers, nil
+}
+
+// applyOptionsSinks replaces the sinks of the options last applied with sinks,
+// writing to writers, and closes the writers of the sinks last applied. The
+// caller must hold optionsOutputLock.
+func applyOptionsSinks(loggers map[string]Logger, sinks []OptionsSink, writers []io.WriteCloser) {
+	if len(sinks) == 0 && len(optionsSinks) == 0 {
+		return
+	}
+
+	for _, v := range loggers {
+		if dl, ok := v.(*daprLogger); ok {
+			dl.setOptionsSinks(sinks, writers)
+		}
+	}
+
+	for _, c := range optionsSinks {
+		c.Close()
+	}
+	optionsSinks = make([]io.Closer, len(writers))
+	for i, w := range writers {
+		optionsSinks[i] = w
+	}
+}
+
+// closeAll closes writers.
+func closeAll(writers []io.WriteCloser) {
+	for _, w := range writers {
+		w.Close()
+	}
+}
+
+// Flush blocks until the logs buffered with Options.AsyncBufferSize are
+// written. It is a no-op if the writes are synchronous.
+func Flush() {
+	if async := optionsAsync.Load(); async != nil {
+		async.Flush()
+	}
+}
+
+// AsyncDroppedTotal returns the number of writes dropped since the process
+// started because the buffer of an AsyncWriter, such as the one of
+// Options.AsyncBufferSize, was full. Unlike AsyncWriter.Dropped, it doesn't
+// start over when the options are applied again.
+func AsyncDroppedTotal() uint64 {
+	return asyncDroppedTotal.Load()
+}
+
+// nopCloser is a writer whose Close is a no-op, such as for os.Stdout.
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
 	return nil
 }
+
+// asyncCloser closes the AsyncWriter writing to w, then w.
+type asyncCloser struct {
+	async *AsyncWriter
+	w     io.Closer
+}
+
+func (c asyncCloser) Close() error {
+	c.async.Close()
+	return c.w.Close()
+}
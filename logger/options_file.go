@@ -0,0 +1,299 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"gopkg.in/yaml.v3"
+)
+
+// optionsFileDebounce is how long WatchOptions waits for the writes to the
+// options file to settle before reloading it.
+const optionsFileDebounce = 100 * time.Millisecond
+
+// optionsFile is the content of the files read by LoadOptions.
+type optionsFile struct {
+	// Level is the default output level
+	Level string `json:"level" yaml:"level"`
+	// Levels are the output levels per logger name or pattern, such as
+	// "components.*"
+	Levels map[string]string `json:"levels" yaml:"levels"`
+	// Format is "text", "json" or one of the JSON layouts
+	Format string `json:"format" yaml:"format"`
+	// Encoding is "text" or "cbor", see Options.OutputEncoding
+	Encoding string `json:"encoding" yaml:"encoding"`
+	// Output is "stdout", one of the targets of Options.OutputTarget, or
+	// else the path of Options.OutputFile
+	Output          string            `json:"output" yaml:"output"`
+	Caller          bool              `json:"caller" yaml:"caller"`
+	StackTrace      bool              `json:"stackTrace" yaml:"stackTrace"`
+	TimestampFormat string            `json:"timestampFormat" yaml:"timestampFormat"`
+	UTC             bool              `json:"utc" yaml:"utc"`
+	Sinks           []optionsFileSink `json:"sinks" yaml:"sinks"`
+	// StructuredErrors adds the error.causes field, see
+	// Options.StructuredErrors
+	StructuredErrors bool `json:"structuredErrors" yaml:"structuredErrors"`
+	// FieldAllowList and FieldDenyList are the patterns of the fields kept
+	// and dropped, see Options.FieldAllowList and Options.FieldDenyList
+	FieldAllowList []string `json:"fieldAllowList" yaml:"fieldAllowList"`
+	FieldDenyList  []string `json:"fieldDenyList" yaml:"fieldDenyList"`
+}
+
+// optionsFileSink is a sink of optionsFile.
+type optionsFileSink struct {
+	Output   string `json:"output" yaml:"output"`
+	Level    string `json:"level" yaml:"level"`
+	Format   string `json:"format" yaml:"format"`
+	Colors   bool   `json:"colors" yaml:"colors"`
+	Encoding string `json:"encoding" yaml:"encoding"`
+}
+
+// LoadOptions returns the default options overridden with the YAML file, or
+// the JSON file if its extension is .json, at path:
+//
+//	level: info
+//	levels:
+//	  components.*: debug
+//	format: json
+//	output: /var/log/dapr.log
+//	sinks:
+//	  - output: stdout
+//	    level: warn
+//	    format: text
+//	    colors: true
+//
+// The format is "text", "json", "ecs", "gcp" or "clef", the encoding of the
+// output and of the sinks "text" or "cbor", and the outputs "stdout",
+// "syslog://host:514", "journald", "eventlog://source" or a file path. Unknown
+// keys are rejected.
+func LoadOptions(path string) (Options, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return Options{}, fmt.Errorf("failed to read log options: %w", err)
+	}
+
+	return parseOptionsFile(path, b)
+}
+
+func parseOptionsFile(path string, b []byte) (Options, error) {
+	var f optionsFile
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&f); err != nil {
+			return Options{}, fmt.Errorf("invalid log options file %s: %w", path, err)
+		}
+	} else {
+		dec := yaml.NewDecoder(bytes.NewReader(b))
+		dec.KnownFields(true)
+		// An empty file has the default options.
+		if err := dec.Decode(&f); err != nil && !errors.Is(err, io.EOF) {
+			return Options{}, fmt.Errorf("invalid log options file %s: %w", path, err)
+		}
+	}
+
+	o := DefaultOptions()
+	if err := o.SetOutputLevel(f.levelSpec()); err != nil {
+		return Options{}, fmt.Errorf("invalid log options file %s: %w", path, err)
+	}
+
+	if f.Format != "" {
+		enabled, format, ok := parseLogFormat(f.Format)
+		if !ok {
+			return Options{}, fmt.Errorf("invalid log options file %s: invalid format %s", path, f.Format)
+		}
+		o.JSONFormatEnabled = enabled
+		o.JSONFormat = string(format)
+	}
+
+	if f.Encoding != "" {
+		encoding, ok := toOutputEncoding(f.Encoding)
+		if !ok {
+			return Options{}, fmt.Errorf("invalid log options file %s: invalid encoding %s", path, f.Encoding)
+		}
+		o.OutputEncoding = string(encoding)
+	}
+
+	if f.Output != "" {
+		o.setOutput(f.Output)
+	}
+
+	o.EnableCaller = f.Caller
+	o.EnableStackTrace = f.StackTrace
+	o.StructuredErrors = f.StructuredErrors
+	o.FieldAllowList = f.FieldAllowList
+	o.FieldDenyList = f.FieldDenyList
+	o.TimestampFormat = f.TimestampFormat
+	o.UTC = f.UTC
+
+	for _, s := range f.Sinks {
+		sink := OptionsSink{Output: s.Output}
+		if sink.Output == "" {
+			sink.Output = envLogOutputStdout
+		}
+
+		if s.Level != "" {
+			sink.Level = toLogLevel(s.Level)
+			if sink.Level == UndefinedLevel {
+				return Options{}, fmt.Errorf("invalid log options file %s: undefined sink level %s", path, s.Level)
+			}
+		}
+
+		if s.Format != "" {
+			enabled, format, ok := parseLogFormat(s.Format)
+			if !ok {
+				return Options{}, fmt.Errorf("invalid log options file %s: invalid sink format %s", path, s.Format)
+			}
+			sink.JSON = enabled
+			sink.JSONFormat = format
+		}
+		sink.Colors = s.Colors
+
+		if s.Encoding != "" {
+			encoding, ok := toOutputEncoding(s.Encoding)
+			if !ok {
+				return Options{}, fmt.Errorf("invalid log options file %s: invalid sink encoding %s", path, s.Encoding)
+			}
+			sink.Encoding = encoding
+		}
+
+		o.Sinks = append(o.Sinks, sink)
+	}
+
+	return o, nil
+}
+
+// levelSpec returns the level spec of Level and Levels, see ApplyLevelSpec.
+func (f optionsFile) levelSpec() string {
+	spec := f.Level
+	if spec == "" {
+		spec = defaultOutputLevel
+	}
+
+	names := make([]string, 0, len(f.Levels))
+	for name := range f.Levels {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		spec += "," + name + ":" + f.Levels[name]
+	}
+
+	return spec
+}
+
+// WatchOptions applies to all registered loggers the options loaded from the
+// file at path, see LoadOptions, then applies them again each time the file
+// changes. It returns a function to stop watching the file. Failures to apply
+// the changed options are printed to stderr and leave the options last
+// applied in place.
+func WatchOptions(path string) (stop func(), err error) {
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read log options: %w", err)
+	}
+	if err = applyOptionsFile(path, b); err != nil {
+		return nil, err
+	}
+
+	// The directory is watched rather than the file, which editors and
+	// Kubernetes ConfigMaps replace instead of writing it in place.
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create watcher: %w", err)
+	}
+	if err = w.Add(filepath.Dir(path)); err != nil {
+		w.Close()
+		return nil, fmt.Errorf("failed to watch %s: %w", path, err)
+	}
+
+	doneCh := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		last := b
+		// The timer is created stopped, and reset on each event.
+		timer := time.NewTimer(time.Hour)
+		timer.Stop()
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-doneCh:
+				return
+			case _, ok := <-w.Events:
+				if !ok {
+					return
+				}
+				timer.Reset(optionsFileDebounce)
+			case err, ok := <-w.Errors:
+				if !ok {
+					return
+				}
+				fmt.Fprintf(os.Stderr, "Failed to watch log options, %v\n", err)
+			case <-timer.C:
+				b, err := os.ReadFile(path)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Failed to reload log options, %v\n", err)
+					continue
+				}
+				if bytes.Equal(b, last) {
+					continue
+				}
+				if err := applyOptionsFile(path, b); err != nil {
+					fmt.Fprintf(os.Stderr, "Failed to reload log options, %v\n", err)
+					continue
+				}
+				last = b
+			}
+		}
+	})
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(doneCh)
+			w.Close()
+			wg.Wait()
+		})
+	}, nil
+}
+
+// applyOptionsFile applies to all registered loggers the options of the
+// file at path with content b.
+func applyOptionsFile(path string, b []byte) error {
+	o, err := parseOptionsFile(path, b)
+	if err != nil {
+		return err
+	}
+
+	return ApplyOptionsToLoggers(&o)
+}
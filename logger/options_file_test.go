@@ -0,0 +1,240 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadOptions(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("yaml", func(t *testing.T) {
+		path := filepath.Join(dir, "log.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(`
+level: warn
+levels:
+  components.*: debug
+  actors: error
+format: ecs
+output: /var/log/dapr.log
+caller: true
+utc: true
+sinks:
+  - output: syslog://127.0.0.1:514
+    level: error
+    format: json
+  - level: info
+    colors: true
+`), 0o600))
+
+		o, err := LoadOptions(path)
+		require.NoError(t, err)
+		assert.Equal(t, "warn,actors:error,components.*:debug", o.OutputLevel)
+		assert.True(t, o.JSONFormatEnabled)
+		assert.Equal(t, "ecs", o.JSONFormat)
+		assert.Equal(t, "/var/log/dapr.log", o.OutputFile)
+		assert.True(t, o.EnableCaller)
+		assert.True(t, o.UTC)
+		assert.Equal(t, []OptionsSink{
+			{Output: "syslog://127.0.0.1:514", SinkOptions: SinkOptions{Level: ErrorLevel, JSON: true}},
+			{Output: "stdout", SinkOptions: SinkOptions{Level: InfoLevel, Colors: true}},
+		}, o.Sinks)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		path := filepath.Join(dir, "log.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{"level":"debug","format":"text","output":"journald"}`), 0o600))
+
+		o, err := LoadOptions(path)
+		require.NoError(t, err)
+		assert.Equal(t, "debug", o.OutputLevel)
+		assert.False(t, o.JSONFormatEnabled)
+		assert.Equal(t, "journald", o.OutputTarget)
+	})
+
+	t.Run("cbor encoding", func(t *testing.T) {
+		path := filepath.Join(dir, "cbor.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(`
+encoding: cbor
+output: /var/log/dapr.cbor
+sinks:
+  - output: /var/log/dapr.sink.cbor
+    encoding: cbor
+`), 0o600))
+
+		o, err := LoadOptions(path)
+		require.NoError(t, err)
+		assert.Equal(t, string(OutputEncodingCBOR), o.OutputEncoding)
+		assert.Equal(t, []OptionsSink{
+			{Output: "/var/log/dapr.sink.cbor", SinkOptions: SinkOptions{Encoding: OutputEncodingCBOR}},
+		}, o.Sinks)
+	})
+
+	t.Run("empty file has the default options", func(t *testing.T) {
+		path := filepath.Join(dir, "empty.yaml")
+		require.NoError(t, os.WriteFile(path, nil, 0o600))
+
+		o, err := LoadOptions(path)
+		require.NoError(t, err)
+		assert.Equal(t, DefaultOptions(), o)
+	})
+
+	t.Run("invalid files", func(t *testing.T) {
+		for name, content := range map[string]string{
+			"unknown.yaml":     "verbosity: 3",
+			"level.yaml":       "level: verbose",
+			"scope.yaml":       "levels: {actors: verbose}",
+			"format.yaml":      "format: logfmt",
+			"sink_level.yaml":  "sinks: [{level: verbose}]",
+			"sink_format.yaml": "sinks: [{format: logfmt}]",
+			"cbor_format.yaml": "format: cbor",
+			"encoding.yaml":    "encoding: msgpack",
+			"sink_enc.yaml":    "sinks: [{encoding: msgpack}]",
+			"unknown.json":     `{"verbosity":3}`,
+		} {
+			t.Run(name, func(t *testing.T) {
+				path := filepath.Join(dir, name)
+				require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+				_, err := LoadOptions(path)
+				require.Error(t, err)
+			})
+		}
+
+		_, err := LoadOptions(filepath.Join(dir, "missing.yaml"))
+		require.Error(t, err)
+	})
+}
+
+func TestApplyOptionsSinks(t *testing.T) {
+	dir := t.TempDir()
+
+	var buf bytes.Buffer
+	testLogger := NewLogger("testLoggerOptionsSinks")
+	testLogger.SetOutput(&buf)
+	t.Cleanup(func() {
+		testLogger.SetOutput(os.Stdout)
+		optionsOutputLock.Lock()
+		applyOptionsSinks(getLoggers(), nil, nil)
+		optionsOutputLock.Unlock()
+	})
+
+	opts := DefaultOptions()
+	opts.Sinks = []OptionsSink{{Output: filepath.Join(dir, "first.log")}}
+	require.NoError(t, ApplyOptionsToLoggers(&opts))
+	testLogger.Info("first")
+
+	// Applying the options again replaces the sinks.
+	opts.Sinks = []OptionsSink{{Output: filepath.Join(dir, "second.log"), SinkOptions: SinkOptions{JSON: true}}}
+	require.NoError(t, ApplyOptionsToLoggers(&opts))
+	testLogger.Info("second")
+
+	first, err := os.ReadFile(filepath.Join(dir, "first.log"))
+	require.NoError(t, err)
+	second, err := os.ReadFile(filepath.Join(dir, "second.log"))
+	require.NoError(t, err)
+
+	assert.Contains(t, string(first), "first")
+	assert.NotContains(t, string(first), "second")
+	assert.Contains(t, string(second), `"msg":"second"`)
+	assert.Contains(t, buf.String(), "first")
+	assert.Contains(t, buf.String(), "second")
+
+	opts.Sinks = []OptionsSink{{Output: filepath.Join(dir, "missing", "dapr.log")}}
+	require.Error(t, ApplyOptionsToLoggers(&opts))
+}
+
+func TestWatchOptionsOutput(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "log.yaml")
+	file := filepath.Join(dir, "dapr.log")
+	require.NoError(t, os.WriteFile(path, []byte("output: "+file), 0o600))
+
+	testLogger := NewLogger("testLoggerWatchOptionsOutput")
+	t.Cleanup(func() {
+		for _, l := range getLoggers() {
+			l.SetOutput(os.Stdout)
+		}
+	})
+
+	stop, err := WatchOptions(path)
+	require.NoError(t, err)
+	t.Cleanup(stop)
+
+	testLogger.Info("to file")
+
+	// Removing the output reverts to stdout.
+	require.NoError(t, os.WriteFile(path, []byte("level: info"), 0o600))
+	assert.Eventually(t, func() bool {
+		testLogger.(*daprLogger).core.lock.Lock()
+		defer testLogger.(*daprLogger).core.lock.Unlock()
+		return testLogger.(*daprLogger).logger.Logger.Out == os.Stdout
+	}, 5*time.Second, 10*time.Millisecond)
+
+	optionsOutputLock.Lock()
+	assert.Nil(t, optionsOutput)
+	optionsOutputLock.Unlock()
+
+	testLogger.Info("to stdout")
+
+	b, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "to file")
+	assert.NotContains(t, string(b), "to stdout")
+}
+
+func TestWatchOptions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "log.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("level: warn"), 0o600))
+
+	testLogger := NewLogger("testLoggerWatchOptions")
+	t.Cleanup(func() {
+		for _, l := range getLoggers() {
+			l.SetOutputLevel(InfoLevel)
+		}
+	})
+
+	stop, err := WatchOptions(path)
+	require.NoError(t, err)
+	t.Cleanup(stop)
+
+	assert.False(t, testLogger.IsOutputLevelEnabled(InfoLevel))
+
+	require.NoError(t, os.WriteFile(path, []byte("level: info\nlevels: {testLoggerWatchOptions: debug}"), 0o600))
+	assert.Eventually(t, func() bool {
+		return testLogger.IsOutputLevelEnabled(DebugLevel)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Invalid changes leave the options last applied in place.
+	require.NoError(t, os.WriteFile(path, []byte("level: verbose"), 0o600))
+	time.Sleep(3 * optionsFileDebounce)
+	assert.True(t, testLogger.IsOutputLevelEnabled(DebugLevel))
+
+	// Even if only a writer fails to open.
+	dir := filepath.Dir(path)
+	require.NoError(t, os.WriteFile(path, []byte("format: json\nsinks: [{output: "+filepath.Join(dir, "missing", "dapr.log")+"}]"), 0o600))
+	time.Sleep(3 * optionsFileDebounce)
+	assert.False(t, isJSONEnabled(testLogger))
+
+	_, err = WatchOptions(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.Error(t, err)
+}
@@ -14,8 +14,16 @@ limitations under the License.
 package logger
 
 import (
+	"bytes"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
 	"testing"
+	"time"
 
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -59,6 +67,34 @@ func TestOptions(t *testing.T) {
 		assert.True(t, logLevelAsserted)
 		assert.True(t, logAsJSONAsserted)
 	})
+
+	t.Run("set output level spec", func(t *testing.T) {
+		o := DefaultOptions()
+
+		require.NoError(t, o.SetOutputLevel("info,components.*:debug"))
+		assert.Equal(t, "info,components.*:debug", o.OutputLevel)
+
+		require.Error(t, o.SetOutputLevel("info,components.*:verbose"))
+		require.Error(t, o.SetOutputLevel("verbose"))
+	})
+}
+
+func TestApplyOptionsLevelSpec(t *testing.T) {
+	state := NewLogger("testLoggerSpec.state")
+	other := NewLogger("testLoggerSpec.other")
+	t.Cleanup(func() {
+		for _, l := range getLoggers() {
+			l.SetOutputLevel(InfoLevel)
+		}
+	})
+
+	opts := DefaultOptions()
+	require.NoError(t, opts.SetOutputLevel("warn,testLoggerSpec.state:debug"))
+	require.NoError(t, ApplyOptionsToLoggers(&opts))
+
+	assert.True(t, state.IsOutputLevelEnabled(DebugLevel))
+	assert.False(t, other.IsOutputLevelEnabled(InfoLevel))
+	assert.True(t, other.IsOutputLevelEnabled(WarnLevel))
 }
 
 func TestApplyOptionsToLoggers(t *testing.T) {
@@ -92,3 +128,210 @@ func TestApplyOptionsToLoggers(t *testing.T) {
 			(l.(*daprLogger)).logger.Logger.GetLevel())
 	}
 }
+
+func TestApplyOptionsOutputFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dapr.log")
+
+	testLogger := NewLogger("testLoggerOutputFile")
+	t.Cleanup(func() {
+		for _, l := range getLoggers() {
+			l.SetOutput(os.Stdout)
+		}
+
+		optionsOutputLock.Lock()
+		optionsOutput.Close()
+		optionsOutput = nil
+		optionsOutputLock.Unlock()
+	})
+
+	opts := DefaultOptions()
+	opts.OutputFile = path
+	opts.MaxSizeMB = 10
+	require.NoError(t, ApplyOptionsToLoggers(&opts))
+
+	testLogger.Info("written to file")
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "written to file")
+
+	opts.OutputFile = filepath.Join(t.TempDir(), "missing", "dapr.log")
+	require.Error(t, ApplyOptionsToLoggers(&opts))
+}
+
+func TestApplyOptionsOTLP(t *testing.T) {
+	collector := newOTLPCollector(t, http.StatusOK)
+
+	var buf bytes.Buffer
+	testLogger := NewLogger("testLoggerOTLP")
+	testLogger.SetOutput(&buf)
+	t.Cleanup(func() {
+		testLogger.SetOutput(os.Stdout)
+
+		optionsOutputLock.Lock()
+		applyOptionsExporter(getLoggers(), nil)
+		optionsOutputLock.Unlock()
+	})
+
+	opts := DefaultOptions()
+	opts.OTLPEndpoint = collector.server.URL
+	require.NoError(t, ApplyOptionsToLoggers(&opts))
+	// Applying the options again replaces the exporter, without exporting
+	// the entries twice.
+	require.NoError(t, ApplyOptionsToLoggers(&opts))
+
+	testLogger.Info("exported")
+	exp := optionsExporter.Load()
+	require.NoError(t, exp.Flush(t.Context()))
+
+	assert.Contains(t, buf.String(), "exported")
+	assert.Len(t, collector.records()["testLoggerOTLP"], 1)
+
+	// Unsetting the endpoint closes the exporter and stops exporting.
+	opts.OTLPEndpoint = ""
+	require.NoError(t, ApplyOptionsToLoggers(&opts))
+	assert.Nil(t, optionsExporter.Load())
+
+	testLogger.Info("not exported")
+	require.NoError(t, exp.Flush(t.Context()))
+
+	assert.Contains(t, buf.String(), "not exported")
+	assert.Len(t, collector.records()["testLoggerOTLP"], 1)
+	assert.Zero(t, exp.Dropped())
+
+	opts.OTLPEndpoint = collector.server.URL
+	opts.OTLPProtocol = "thrift"
+	require.Error(t, ApplyOptionsToLoggers(&opts))
+	assert.Nil(t, optionsExporter.Load())
+}
+
+func TestApplyOptionsOutputTarget(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	testLogger := NewLogger("testLoggerOutputTarget")
+	t.Cleanup(func() {
+		for _, l := range getLoggers() {
+			l.SetOutput(os.Stdout)
+		}
+
+		optionsOutputLock.Lock()
+		optionsOutput.Close()
+		optionsOutput = nil
+		optionsOutputLock.Unlock()
+	})
+
+	opts := DefaultOptions()
+	opts.OutputTarget = "syslog://" + conn.LocalAddr().String()
+	require.NoError(t, ApplyOptionsToLoggers(&opts))
+
+	testLogger.Error("sent to syslog")
+	assert.Contains(t, readUDPMessage(t, conn), "sent to syslog")
+
+	opts.OutputTarget = "kafka://host:9092"
+	require.Error(t, ApplyOptionsToLoggers(&opts))
+
+	if runtime.GOOS != "windows" {
+		opts.OutputTarget = "eventlog://dapr"
+		require.Error(t, ApplyOptionsToLoggers(&opts))
+	}
+}
+
+func TestApplyOptionsAsync(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dapr.log")
+
+	testLogger := NewLogger("testLoggerAsync")
+	t.Cleanup(func() {
+		for _, l := range getLoggers() {
+			l.SetOutput(os.Stdout)
+		}
+
+		optionsOutputLock.Lock()
+		optionsOutput.Close()
+		optionsOutput = nil
+		optionsAsync.Store(nil)
+		optionsOutputLock.Unlock()
+	})
+
+	dropped := AsyncDroppedTotal()
+
+	opts := DefaultOptions()
+	opts.OutputFile = path
+	opts.AsyncBufferSize = 128
+	require.NoError(t, ApplyOptionsToLoggers(&opts))
+
+	testLogger.Info("written in the background")
+	Flush()
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "written in the background")
+	assert.Equal(t, dropped, AsyncDroppedTotal())
+
+	// Unsetting the buffer size makes the writes synchronous again.
+	opts.AsyncBufferSize = 0
+	require.NoError(t, ApplyOptionsToLoggers(&opts))
+	assert.Nil(t, optionsAsync.Load())
+
+	testLogger.Info("written synchronously")
+
+	b, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "written synchronously")
+}
+
+func TestApplyOptionsTimestampFormat(t *testing.T) {
+	testLogger := NewLogger("testLoggerTimestampFormat")
+	t.Cleanup(func() {
+		for _, l := range getLoggers() {
+			l.SetTimestampFormat("", false)
+		}
+	})
+
+	opts := DefaultOptions()
+	opts.JSONFormatEnabled = true
+	opts.TimestampFormat = time.RFC3339
+	opts.UTC = true
+	require.NoError(t, ApplyOptionsToLoggers(&opts))
+
+	dl := testLogger.(*daprLogger)
+	assert.Equal(t, time.RFC3339, dl.logger.Logger.Formatter.(*logrus.JSONFormatter).TimestampFormat)
+	assert.True(t, dl.core.utc.Load())
+}
+
+func TestApplyOptionsJSONFormat(t *testing.T) {
+	testLogger := NewLogger("testLoggerJSONFormat")
+	t.Cleanup(func() {
+		for _, l := range getLoggers() {
+			l.SetJSONFormat(JSONFormatDefault)
+		}
+	})
+
+	opts := DefaultOptions()
+	opts.JSONFormatEnabled = true
+	opts.JSONFormat = "ecs"
+	require.NoError(t, ApplyOptionsToLoggers(&opts))
+	assert.IsType(t, &ecsFormatter{}, testLogger.(*daprLogger).logger.Logger.Formatter)
+
+	opts.JSONFormat = "logfmt"
+	require.Error(t, ApplyOptionsToLoggers(&opts))
+}
+
+func TestApplyOptionsFieldFilter(t *testing.T) {
+	testLogger := NewLogger("testLoggerFieldFilter")
+	t.Cleanup(func() {
+		for _, l := range getLoggers() {
+			l.SetFieldFilter(nil, nil)
+		}
+	})
+
+	opts := DefaultOptions()
+	opts.FieldAllowList = []string{"http.*"}
+	opts.FieldDenyList = []string{"payload"}
+	require.NoError(t, ApplyOptionsToLoggers(&opts))
+	assert.Equal(t, &fieldFilter{allow: []string{"http.*"}, deny: []string{"payload"}}, testLogger.(*daprLogger).core.fieldFilter.Load())
+
+	opts.FieldDenyList = []string{"[payload"}
+	require.Error(t, ApplyOptionsToLoggers(&opts))
+}
@@ -0,0 +1,511 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+	kclock "k8s.io/utils/clock"
+)
+
+const (
+	// DefaultOTLPBatchSize is the number of records an OTLPExporter sends at once.
+	DefaultOTLPBatchSize = 512
+	// DefaultOTLPFlushInterval is how often an OTLPExporter sends the pending records.
+	DefaultOTLPFlushInterval = 5 * time.Second
+	// DefaultOTLPTimeout is the timeout of an OTLPExporter export request.
+	DefaultOTLPTimeout = 10 * time.Second
+
+	// otlpMaxQueueBatches is the number of full batches an OTLPExporter holds
+	// before dropping new records.
+	otlpMaxQueueBatches = 4
+	// otlpMaxResponseBytes is the size of the OTLP/HTTP responses read for a
+	// partial success.
+	otlpMaxResponseBytes = 64 << 10
+)
+
+// OTel log attributes our standard fields are mapped to.
+const (
+	otlpAttrScope   = "dapr.scope"
+	otlpAttrAppID   = "dapr.app_id"
+	otlpAttrLogType = "dapr.log_type"
+)
+
+// OTLPProtocol is the transport of an OTLPExporter.
+type OTLPProtocol string
+
+const (
+	// OTLPProtocolHTTPJSON sends the records over OTLP/HTTP with the JSON
+	// encoding.
+	OTLPProtocolHTTPJSON OTLPProtocol = "http/json"
+	// OTLPProtocolGRPC sends the records over OTLP/gRPC.
+	OTLPProtocolGRPC OTLPProtocol = "grpc"
+)
+
+// toOTLPProtocol converts to OTLPProtocol, returning false if protocol is
+// unknown. An empty protocol is OTLPProtocolHTTPJSON.
+func toOTLPProtocol(protocol string) (OTLPProtocol, bool) {
+	switch p := OTLPProtocol(strings.ToLower(protocol)); p {
+	case "", OTLPProtocolHTTPJSON:
+		return OTLPProtocolHTTPJSON, true
+	case OTLPProtocolGRPC:
+		return p, true
+	default:
+		return "", false
+	}
+}
+
+// OTLPExporterOptions configures an OTLPExporter.
+type OTLPExporterOptions struct {
+	// Endpoint is the OTLP/HTTP logs URL of the collector, such as
+	// http://localhost:4318/v1/logs, or its host:port for OTLP/gRPC, such
+	// as localhost:4317.
+	Endpoint string
+	// Protocol is the transport of the export requests.
+	// Defaults to OTLPProtocolHTTPJSON.
+	Protocol OTLPProtocol
+	// Headers are added to each export request, such as for authentication.
+	Headers map[string]string
+	// BatchSize is the number of records sent at once.
+	// Defaults to DefaultOTLPBatchSize.
+	BatchSize int
+	// FlushInterval is how often the pending records are sent.
+	// Defaults to DefaultOTLPFlushInterval.
+	FlushInterval time.Duration
+	// Timeout is the timeout of each export request.
+	// Defaults to DefaultOTLPTimeout.
+	Timeout time.Duration
+	// Client is the HTTP client sending the export requests.
+	// Defaults to http.DefaultClient.
+	Client *http.Client
+	// DialOptions are the options of the gRPC connection to the collector,
+	// with OTLPProtocolGRPC. Defaults to an insecure connection.
+	DialOptions []grpc.DialOption
+}
+
+// OTLPExporter ships log entries in batches to an OpenTelemetry collector
+// over OTLP/HTTP with the JSON encoding, or over OTLP/gRPC.
+// Register it on a logger with AttachOTLPExporter.
+type OTLPExporter struct {
+	opts    OTLPExporterOptions
+	lock    sync.Mutex
+	pending []Entry
+	dropped atomic.Uint64
+	closed  bool
+	flushCh chan struct{}
+	closeCh chan struct{}
+	wg      sync.WaitGroup
+	// conn and logs send the export requests with OTLPProtocolGRPC
+	conn *grpc.ClientConn
+	logs collogspb.LogsServiceClient
+}
+
+// NewOTLPExporter returns an OTLPExporter sending the entries to the collector
+// at opts.Endpoint every opts.FlushInterval, or as soon as a batch is full.
+func NewOTLPExporter(opts OTLPExporterOptions) (*OTLPExporter, error) {
+	return newOTLPExporter(opts, kclock.RealClock{})
+}
+
+func newOTLPExporter(opts OTLPExporterOptions, clock kclock.WithTicker) (*OTLPExporter, error) {
+	if opts.Endpoint == "" {
+		return nil, errors.New("OTLP endpoint is required")
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultOTLPBatchSize
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = DefaultOTLPFlushInterval
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultOTLPTimeout
+	}
+	if opts.Client == nil {
+		opts.Client = http.DefaultClient
+	}
+
+	e := &OTLPExporter{
+		opts:    opts,
+		flushCh: make(chan struct{}, 1),
+		closeCh: make(chan struct{}),
+	}
+
+	switch opts.Protocol {
+	case "", OTLPProtocolHTTPJSON:
+		e.opts.Protocol = OTLPProtocolHTTPJSON
+	case OTLPProtocolGRPC:
+		dialOpts := opts.DialOptions
+		if len(dialOpts) == 0 {
+			dialOpts = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+		}
+		conn, err := grpc.NewClient(opts.Endpoint, dialOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP gRPC client: %w", err)
+		}
+		e.conn = conn
+		e.logs = collogspb.NewLogsServiceClient(conn)
+	default:
+		return nil, fmt.Errorf("unknown OTLP protocol: %s", opts.Protocol)
+	}
+
+	ticker := clock.NewTicker(opts.FlushInterval)
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		defer ticker.Stop()
+		for {
+			select {
+			case <-e.closeCh:
+				return
+			case <-ticker.C():
+			case <-e.flushCh:
+			}
+			_ = e.exportPending(context.Background())
+		}
+	}()
+
+	return e, nil
+}
+
+// Export queues entry to be sent with the next batch.
+// The entry is dropped if the exporter is closed or too many entries are
+// pending, for example because the collector is unreachable.
+func (e *OTLPExporter) Export(entry Entry) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.closed || len(e.pending) >= e.opts.BatchSize*otlpMaxQueueBatches {
+		e.dropped.Add(1)
+		return
+	}
+
+	e.pending = append(e.pending, entry)
+	if len(e.pending) >= e.opts.BatchSize {
+		select {
+		case e.flushCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Dropped returns the number of entries dropped, at export or because too
+// many entries were pending.
+func (e *OTLPExporter) Dropped() uint64 {
+	return e.dropped.Load()
+}
+
+// Flush sends all the pending entries and returns the first export error.
+func (e *OTLPExporter) Flush(ctx context.Context) error {
+	return e.exportPending(ctx)
+}
+
+// Close stops the periodic export and sends the pending entries.
+func (e *OTLPExporter) Close() error {
+	e.lock.Lock()
+	if e.closed {
+		e.lock.Unlock()
+		return nil
+	}
+	e.closed = true
+	close(e.closeCh)
+	e.lock.Unlock()
+
+	e.wg.Wait()
+
+	err := e.exportPending(context.Background())
+	if e.conn != nil {
+		err = errors.Join(err, e.conn.Close())
+	}
+
+	return err
+}
+
+// exportPending sends the pending entries in batches of at most BatchSize.
+// The entries of a batch that failed to export are counted as dropped.
+func (e *OTLPExporter) exportPending(ctx context.Context) error {
+	for {
+		e.lock.Lock()
+		n := min(len(e.pending), e.opts.BatchSize)
+		batch := e.pending[:n:n]
+		e.pending = e.pending[n:]
+		e.lock.Unlock()
+
+		if len(batch) == 0 {
+			return nil
+		}
+
+		if err := e.export(ctx, batch); err != nil {
+			e.dropped.Add(uint64(len(batch)))
+			return err
+		}
+	}
+}
+
+func (e *OTLPExporter) export(ctx context.Context, batch []Entry) error {
+	if e.logs != nil {
+		return e.exportGRPC(ctx, batch)
+	}
+
+	body, err := otlpJSONLogsRequest(otlpProtoLogsRequest(batch))
+	if err != nil {
+		return fmt.Errorf("failed to encode OTLP logs: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, e.opts.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.opts.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create OTLP request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range e.opts.Headers {
+		req.Header.Set(k, v)
+	}
+
+	res, err := e.opts.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to export OTLP logs: %w", err)
+	}
+	defer res.Body.Close()
+	resBody, _ := io.ReadAll(io.LimitReader(res.Body, otlpMaxResponseBytes))
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("failed to export OTLP logs: collector returned status %d", res.StatusCode)
+	}
+
+	// The records rejected in a partial success are dropped, the others were
+	// exported.
+	var exportRes collogspb.ExportLogsServiceResponse
+	if err = (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(resBody, &exportRes); err == nil {
+		if rejected := exportRes.GetPartialSuccess().GetRejectedLogRecords(); rejected > 0 {
+			e.dropped.Add(uint64(rejected))
+		}
+	}
+
+	return nil
+}
+
+// AttachOTLPExporter exports each entry written by the logger with exp, in
+// addition to writing it to the logger output.
+func AttachOTLPExporter(l Logger, exp *OTLPExporter) {
+	l.OnWrite(func(entry Entry, _ int, _ error) {
+		exp.Export(entry)
+	})
+}
+
+// otlpProtoLogsRequest returns the ExportLogsServiceRequest of batch, with one
+// instrumentation scope per logger name.
+func otlpProtoLogsRequest(batch []Entry) *collogspb.ExportLogsServiceRequest {
+	var (
+		scopes  []string
+		records = make(map[string][]*logspb.LogRecord)
+	)
+	for _, entry := range batch {
+		if _, ok := records[entry.Scope]; !ok {
+			scopes = append(scopes, entry.Scope)
+		}
+		records[entry.Scope] = append(records[entry.Scope], otlpProtoRecord(entry))
+	}
+
+	scopeLogs := make([]*logspb.ScopeLogs, 0, len(scopes))
+	for _, scope := range scopes {
+		scopeLogs = append(scopeLogs, &logspb.ScopeLogs{
+			Scope:      &commonpb.InstrumentationScope{Name: scope},
+			LogRecords: records[scope],
+		})
+	}
+
+	return &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource:  &resourcepb.Resource{},
+			ScopeLogs: scopeLogs,
+		}},
+	}
+}
+
+// otlpProtoRecord maps entry to an OTel log record: scope, app_id and type
+// become dapr.* attributes, trace_id and span_id the record trace context, and
+// the other fields attributes with the same key. The trace_id and span_id
+// fields that aren't hex are left out.
+func otlpProtoRecord(entry Entry) *logspb.LogRecord {
+	rec := &logspb.LogRecord{
+		TimeUnixNano:   uint64(entry.Time.UnixNano()), //nolint:gosec
+		SeverityNumber: logspb.SeverityNumber(otlpSeverityNumber(entry.Level)),
+		SeverityText:   otlpSeverityText(entry.Level),
+		Body:           &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: entry.Message}},
+	}
+
+	for k, v := range entry.Fields {
+		switch k {
+		case logFieldScope:
+			rec.Attributes = append(rec.Attributes, otlpProtoKeyValue(otlpAttrScope, v))
+		case logFieldAppID:
+			rec.Attributes = append(rec.Attributes, otlpProtoKeyValue(otlpAttrAppID, v))
+		case logFieldType:
+			rec.Attributes = append(rec.Attributes, otlpProtoKeyValue(otlpAttrLogType, v))
+		case logFieldTraceID:
+			rec.TraceId = otlpProtoID(v)
+		case logFieldSpanID:
+			rec.SpanId = otlpProtoID(v)
+		default:
+			rec.Attributes = append(rec.Attributes, otlpProtoKeyValue(k, v))
+		}
+	}
+
+	return rec
+}
+
+func otlpProtoKeyValue(k string, v any) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: k, Value: otlpProtoValue(v)}
+}
+
+func otlpProtoValue(v any) *commonpb.AnyValue {
+	switch v := v.(type) {
+	case string:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v}}
+	case bool:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: v}}
+	case int:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case int32:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case int64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: v}}
+	case uint32:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case float32:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: float64(v)}}
+	case float64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: v}}
+	case error:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v.Error()}}
+	default:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: fmt.Sprint(v)}}
+	}
+}
+
+// otlpProtoID returns the bytes of a hex trace or span ID, or nil if v isn't
+// one.
+func otlpProtoID(v any) []byte {
+	s, _ := v.(string)
+	id, err := hex.DecodeString(s)
+	if err != nil || len(id) == 0 {
+		return nil
+	}
+
+	return id
+}
+
+// otlpJSONLogsRequest returns the OTLP/JSON encoding of req. Unlike the
+// protobuf JSON mapping, OTLP/JSON encodes the enums as numbers and the trace
+// and span IDs as hex instead of base64.
+func otlpJSONLogsRequest(req *collogspb.ExportLogsServiceRequest) ([]byte, error) {
+	b, err := protojson.MarshalOptions{UseEnumNumbers: true}.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc map[string]any
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err = dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	for _, rl := range jsonArray(doc["resourceLogs"]) {
+		for _, sl := range jsonArray(jsonObject(rl)["scopeLogs"]) {
+			for _, rec := range jsonArray(jsonObject(sl)["logRecords"]) {
+				rec := jsonObject(rec)
+				for _, k := range []string{"traceId", "spanId"} {
+					if s, ok := rec[k].(string); ok {
+						id, _ := base64.StdEncoding.DecodeString(s)
+						rec[k] = hex.EncodeToString(id)
+					}
+				}
+			}
+		}
+	}
+
+	return json.Marshal(doc)
+}
+
+func jsonArray(v any) []any {
+	a, _ := v.([]any)
+	return a
+}
+
+func jsonObject(v any) map[string]any {
+	o, _ := v.(map[string]any)
+	return o
+}
+
+// otlpSeverityNumber maps a LogLevel to the first OTel severity number of
+// the matching range.
+func otlpSeverityNumber(lvl LogLevel) int {
+	switch lvl {
+	case TraceLevel:
+		return 1
+	case DebugLevel:
+		return 5
+	case InfoLevel:
+		return 9
+	case WarnLevel:
+		return 13
+	case ErrorLevel:
+		return 17
+	case FatalLevel, PanicLevel:
+		return 21
+	default:
+		return 0
+	}
+}
+
+func otlpSeverityText(lvl LogLevel) string {
+	switch lvl {
+	case TraceLevel:
+		return "TRACE"
+	case DebugLevel:
+		return "DEBUG"
+	case InfoLevel:
+		return "INFO"
+	case WarnLevel:
+		return "WARN"
+	case ErrorLevel:
+		return "ERROR"
+	case FatalLevel, PanicLevel:
+		return "FATAL"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+type otlpCollector struct {
+	lock     sync.Mutex
+	requests []map[string]any
+	headers  []http.Header
+	server   *httptest.Server
+	// response is the body of the responses
+	response string
+}
+
+func newOTLPCollector(t *testing.T, status int) *otlpCollector {
+	t.Helper()
+
+	c := &otlpCollector{}
+	c.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		c.lock.Lock()
+		c.requests = append(c.requests, req)
+		c.headers = append(c.headers, r.Header.Clone())
+		response := c.response
+		c.lock.Unlock()
+
+		w.WriteHeader(status)
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(c.server.Close)
+
+	return c
+}
+
+// records returns the log records of all requests, by instrumentation scope.
+func (c *otlpCollector) records() map[string][]map[string]any {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	res := make(map[string][]map[string]any)
+	for _, req := range c.requests {
+		for _, rl := range req["resourceLogs"].([]any) {
+			for _, sl := range rl.(map[string]any)["scopeLogs"].([]any) {
+				sl := sl.(map[string]any)
+				name := sl["scope"].(map[string]any)["name"].(string)
+				for _, rec := range sl["logRecords"].([]any) {
+					res[name] = append(res[name], rec.(map[string]any))
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+func (c *otlpCollector) requestCount() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return len(c.requests)
+}
+
+func otlpAttributes(rec map[string]any) map[string]any {
+	res := make(map[string]any)
+	for _, kv := range rec["attributes"].([]any) {
+		kv := kv.(map[string]any)
+		for _, v := range kv["value"].(map[string]any) {
+			res[kv["key"].(string)] = v
+		}
+	}
+
+	return res
+}
+
+func TestOTLPExporter(t *testing.T) {
+	t.Run("endpoint is required", func(t *testing.T) {
+		_, err := NewOTLPExporter(OTLPExporterOptions{})
+		require.Error(t, err)
+	})
+
+	t.Run("maps the entries to log records", func(t *testing.T) {
+		collector := newOTLPCollector(t, http.StatusOK)
+
+		exp, err := NewOTLPExporter(OTLPExporterOptions{
+			Endpoint: collector.server.URL,
+			Headers:  map[string]string{"Authorization": "Bearer token"},
+		})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		testLogger := getTestLogger(&buf)
+		testLogger.SetAppID("myapp")
+		AttachOTLPExporter(testLogger, exp)
+
+		testLogger.WithFields(map[string]any{
+			"count":         3,
+			logFieldTraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+			logFieldSpanID:  "00f067aa0ba902b7",
+		}).Warn("disk almost full")
+
+		require.NoError(t, exp.Close())
+		assert.Contains(t, buf.String(), "disk almost full")
+
+		records := collector.records()[fakeLoggerName]
+		require.Len(t, records, 1)
+
+		rec := records[0]
+		assert.Equal(t, "disk almost full", rec["body"].(map[string]any)["stringValue"])
+		assert.InDelta(t, 13, rec["severityNumber"], 0)
+		assert.Equal(t, "WARN", rec["severityText"])
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", rec["traceId"])
+		assert.Equal(t, "00f067aa0ba902b7", rec["spanId"])
+		assert.NotEmpty(t, rec["timeUnixNano"])
+
+		attrs := otlpAttributes(rec)
+		assert.Equal(t, fakeLoggerName, attrs[otlpAttrScope])
+		assert.Equal(t, "myapp", attrs[otlpAttrAppID])
+		assert.Equal(t, LogTypeLog, attrs[otlpAttrLogType])
+		assert.Equal(t, "3", attrs["count"])
+		assert.NotContains(t, attrs, logFieldTraceID)
+
+		assert.Equal(t, "application/json", collector.headers[0].Get("Content-Type"))
+		assert.Equal(t, "Bearer token", collector.headers[0].Get("Authorization"))
+	})
+
+	t.Run("non-finite numbers", func(t *testing.T) {
+		collector := newOTLPCollector(t, http.StatusOK)
+
+		exp, err := NewOTLPExporter(OTLPExporterOptions{Endpoint: collector.server.URL})
+		require.NoError(t, err)
+
+		exp.Export(Entry{Scope: "nan", Level: InfoLevel, Message: "m", Fields: map[string]any{"ratio": math.NaN()}})
+		exp.Export(Entry{Scope: "nan", Level: InfoLevel, Message: "m", Fields: map[string]any{"ratio": math.Inf(1)}})
+		require.NoError(t, exp.Close())
+
+		records := collector.records()["nan"]
+		require.Len(t, records, 2)
+		assert.Equal(t, "NaN", otlpAttributes(records[0])["ratio"])
+		assert.Equal(t, "Infinity", otlpAttributes(records[1])["ratio"])
+		assert.Zero(t, exp.Dropped())
+	})
+
+	t.Run("partial success", func(t *testing.T) {
+		collector := newOTLPCollector(t, http.StatusOK)
+		collector.response = `{"partialSuccess":{"rejectedLogRecords":"1","errorMessage":"record too large"}}`
+
+		exp, err := NewOTLPExporter(OTLPExporterOptions{Endpoint: collector.server.URL})
+		require.NoError(t, err)
+		t.Cleanup(func() { exp.Close() })
+
+		for range 3 {
+			exp.Export(Entry{Scope: "partial", Level: InfoLevel, Message: "m"})
+		}
+
+		require.NoError(t, exp.Flush(t.Context()))
+		assert.Len(t, collector.records()["partial"], 3)
+		assert.Equal(t, uint64(1), exp.Dropped())
+	})
+
+	t.Run("sends full batches", func(t *testing.T) {
+		collector := newOTLPCollector(t, http.StatusOK)
+
+		exp, err := NewOTLPExporter(OTLPExporterOptions{
+			Endpoint:  collector.server.URL,
+			BatchSize: 2,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() { exp.Close() })
+
+		for range 4 {
+			exp.Export(Entry{Scope: "batch", Level: InfoLevel, Message: "m"})
+		}
+
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			assert.Len(c, collector.records()["batch"], 4)
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.GreaterOrEqual(t, collector.requestCount(), 2)
+	})
+
+	t.Run("sends on flush interval", func(t *testing.T) {
+		collector := newOTLPCollector(t, http.StatusOK)
+		clock := clocktesting.NewFakeClock(time.Now())
+
+		exp, err := newOTLPExporter(OTLPExporterOptions{
+			Endpoint:      collector.server.URL,
+			FlushInterval: time.Second,
+		}, clock)
+		require.NoError(t, err)
+		t.Cleanup(func() { exp.Close() })
+
+		exp.Export(Entry{Scope: "interval", Level: InfoLevel, Message: "m"})
+		assert.Equal(t, 0, collector.requestCount())
+
+		assert.Eventually(t, clock.HasWaiters, 5*time.Second, 10*time.Millisecond)
+		clock.Step(time.Second)
+
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			assert.Len(c, collector.records()["interval"], 1)
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("collector error", func(t *testing.T) {
+		collector := newOTLPCollector(t, http.StatusServiceUnavailable)
+
+		exp, err := NewOTLPExporter(OTLPExporterOptions{Endpoint: collector.server.URL})
+		require.NoError(t, err)
+		t.Cleanup(func() { exp.Close() })
+
+		exp.Export(Entry{Level: ErrorLevel, Message: "m"})
+
+		require.ErrorContains(t, exp.Flush(t.Context()), "503")
+		assert.Equal(t, uint64(1), exp.Dropped())
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		collector := newOTLPCollector(t, http.StatusOK)
+
+		exp, err := NewOTLPExporter(OTLPExporterOptions{Endpoint: collector.server.URL})
+		require.NoError(t, err)
+		require.NoError(t, exp.Close())
+
+		exp.Export(Entry{Level: InfoLevel, Message: "m"})
+		assert.Equal(t, uint64(1), exp.Dropped())
+		assert.Equal(t, 0, collector.requestCount())
+	})
+}
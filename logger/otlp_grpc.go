@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// exportGRPC sends batch to the collector over OTLP/gRPC. The records the
+// collector rejects in a partial success are counted as dropped, the others
+// were exported.
+func (e *OTLPExporter) exportGRPC(ctx context.Context, batch []Entry) error {
+	ctx, cancel := context.WithTimeout(ctx, e.opts.Timeout)
+	defer cancel()
+
+	for k, v := range e.opts.Headers {
+		ctx = metadata.AppendToOutgoingContext(ctx, k, v)
+	}
+
+	res, err := e.logs.Export(ctx, otlpProtoLogsRequest(batch))
+	if err != nil {
+		return fmt.Errorf("failed to export OTLP logs: %w", err)
+	}
+
+	if rejected := res.GetPartialSuccess().GetRejectedLogRecords(); rejected > 0 {
+		e.dropped.Add(uint64(rejected))
+	}
+
+	return nil
+}
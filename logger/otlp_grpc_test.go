@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type otlpGRPCCollector struct {
+	collogspb.UnimplementedLogsServiceServer
+
+	lock     sync.Mutex
+	requests []*collogspb.ExportLogsServiceRequest
+	metadata []metadata.MD
+	addr     string
+	// rejected is the number of records of each request it rejects
+	rejected int64
+}
+
+func newOTLPGRPCCollector(t *testing.T) *otlpGRPCCollector {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	c := &otlpGRPCCollector{addr: lis.Addr().String()}
+	srv := grpc.NewServer()
+	collogspb.RegisterLogsServiceServer(srv, c)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	return c
+}
+
+func (c *otlpGRPCCollector) Export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.requests = append(c.requests, req)
+	c.metadata = append(c.metadata, md)
+
+	if c.rejected > 0 {
+		return &collogspb.ExportLogsServiceResponse{
+			PartialSuccess: &collogspb.ExportLogsPartialSuccess{
+				RejectedLogRecords: c.rejected,
+				ErrorMessage:       "record too large",
+			},
+		}, nil
+	}
+
+	return &collogspb.ExportLogsServiceResponse{}, nil
+}
+
+func (c *otlpGRPCCollector) records() map[string][]*logspb.LogRecord {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	records := make(map[string][]*logspb.LogRecord)
+	for _, req := range c.requests {
+		for _, rl := range req.GetResourceLogs() {
+			for _, sl := range rl.GetScopeLogs() {
+				records[sl.GetScope().GetName()] = append(records[sl.GetScope().GetName()], sl.GetLogRecords()...)
+			}
+		}
+	}
+
+	return records
+}
+
+func TestOTLPExporterGRPC(t *testing.T) {
+	t.Run("unknown protocol", func(t *testing.T) {
+		_, err := NewOTLPExporter(OTLPExporterOptions{Endpoint: "localhost:4317", Protocol: "thrift"})
+		require.Error(t, err)
+	})
+
+	t.Run("maps the entries to log records", func(t *testing.T) {
+		collector := newOTLPGRPCCollector(t)
+
+		exp, err := NewOTLPExporter(OTLPExporterOptions{
+			Endpoint: collector.addr,
+			Protocol: OTLPProtocolGRPC,
+			Headers:  map[string]string{"authorization": "Bearer token"},
+		})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		testLogger := getTestLogger(&buf)
+		testLogger.SetAppID("myapp")
+		AttachOTLPExporter(testLogger, exp)
+
+		testLogger.WithFields(map[string]any{
+			"count":         3,
+			logFieldTraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+			logFieldSpanID:  "not hex",
+		}).Warn("disk almost full")
+
+		require.NoError(t, exp.Close())
+
+		records := collector.records()[fakeLoggerName]
+		require.Len(t, records, 1)
+
+		rec := records[0]
+		assert.Equal(t, "disk almost full", rec.GetBody().GetStringValue())
+		assert.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_WARN, rec.GetSeverityNumber())
+		assert.Equal(t, "WARN", rec.GetSeverityText())
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", hex.EncodeToString(rec.GetTraceId()))
+		assert.Empty(t, rec.GetSpanId())
+		assert.NotZero(t, rec.GetTimeUnixNano())
+
+		attrs := make(map[string]any)
+		for _, kv := range rec.GetAttributes() {
+			switch v := kv.GetValue().GetValue().(type) {
+			case *commonpb.AnyValue_StringValue:
+				attrs[kv.GetKey()] = v.StringValue
+			case *commonpb.AnyValue_IntValue:
+				attrs[kv.GetKey()] = v.IntValue
+			}
+		}
+		assert.Equal(t, fakeLoggerName, attrs[otlpAttrScope])
+		assert.Equal(t, "myapp", attrs[otlpAttrAppID])
+		assert.Equal(t, int64(3), attrs["count"])
+		assert.NotContains(t, attrs, logFieldTraceID)
+
+		assert.Equal(t, []string{"Bearer token"}, collector.metadata[0].Get("authorization"))
+	})
+
+	t.Run("partial success", func(t *testing.T) {
+		collector := newOTLPGRPCCollector(t)
+		collector.rejected = 1
+
+		exp, err := NewOTLPExporter(OTLPExporterOptions{Endpoint: collector.addr, Protocol: OTLPProtocolGRPC})
+		require.NoError(t, err)
+		t.Cleanup(func() { exp.Close() })
+
+		for range 3 {
+			exp.Export(Entry{Scope: "partial", Level: InfoLevel, Message: "m"})
+		}
+
+		require.NoError(t, exp.Flush(t.Context()))
+		assert.Len(t, collector.records()["partial"], 3)
+		assert.Equal(t, uint64(1), exp.Dropped())
+	})
+
+	t.Run("collector unreachable", func(t *testing.T) {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		addr := lis.Addr().String()
+		lis.Close()
+
+		exp, err := NewOTLPExporter(OTLPExporterOptions{Endpoint: addr, Protocol: OTLPProtocolGRPC})
+		require.NoError(t, err)
+		t.Cleanup(func() { exp.Close() })
+
+		exp.Export(Entry{Level: InfoLevel, Message: "m"})
+		require.Error(t, exp.Flush(t.Context()))
+		assert.Equal(t, uint64(1), exp.Dropped())
+	})
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OutputEncoding is the encoding of the entries written to an output.
+type OutputEncoding string
+
+const (
+	// OutputEncodingText writes each entry as a line of text or JSON, see
+	// EnableJSONOutput and SetJSONFormat.
+	OutputEncodingText OutputEncoding = ""
+	// OutputEncodingCBOR writes the entries with the Dapr layout as a
+	// sequence of CBOR (RFC 8949) maps instead of lines, for the high-volume
+	// pipelines where encoding JSON is costly. See DecodeCBOREntries.
+	// It can't be used with the outputs taking lines of text, such as syslog,
+	// journald, the Windows Event Log or the colorized sinks.
+	OutputEncodingCBOR OutputEncoding = "cbor"
+)
+
+// toOutputEncoding converts to OutputEncoding, returning false if encoding is
+// unknown.
+func toOutputEncoding(encoding string) (OutputEncoding, bool) {
+	switch e := OutputEncoding(strings.ToLower(encoding)); e {
+	case OutputEncodingText, OutputEncodingCBOR:
+		return e, true
+	case "text":
+		return OutputEncodingText, true
+	default:
+		return OutputEncodingText, false
+	}
+}
+
+// SetOutputEncoding sets the encoding of the entries written to the output.
+// With OutputEncodingCBOR, the JSON output and layout settings have no effect
+// until the encoding is set back to OutputEncodingText.
+func (l *daprLogger) SetOutputEncoding(encoding OutputEncoding) {
+	l.core.lock.Lock()
+	defer l.core.lock.Unlock()
+
+	jsonEnabled := isJSONFormatter(l.logger.Logger.Formatter)
+	l.core.outputEncoding = encoding
+	l.logger.Logger.SetFormatter(l.newFormatterLocked(jsonEnabled))
+}
+
+// validateOptionsEncoding returns the OutputEncoding of options, or an error if
+// it's unknown or can't be written to the output or to a sink.
+func validateOptionsEncoding(options *Options) (OutputEncoding, error) {
+	encoding, ok := toOutputEncoding(options.OutputEncoding)
+	if !ok {
+		return OutputEncodingText, fmt.Errorf("invalid value for OutputEncoding: %s", options.OutputEncoding)
+	}
+	if encoding == OutputEncodingCBOR && options.OutputTarget != "" {
+		return OutputEncodingText, fmt.Errorf("invalid value for OutputEncoding: %s can't be written to %s", encoding, options.OutputTarget)
+	}
+
+	for _, s := range options.Sinks {
+		sinkEncoding, ok := toOutputEncoding(string(s.Encoding))
+		if !ok {
+			return OutputEncodingText, fmt.Errorf("invalid sink encoding: %s", s.Encoding)
+		}
+		if sinkEncoding != OutputEncodingCBOR {
+			continue
+		}
+		if s.Colors {
+			return OutputEncodingText, fmt.Errorf("invalid sink encoding: %s can't be colorized", sinkEncoding)
+		}
+		if isTextTarget(s.Output) {
+			return OutputEncodingText, fmt.Errorf("invalid sink encoding: %s can't be written to %s", sinkEncoding, s.Output)
+		}
+	}
+
+	return encoding, nil
+}
+
+// isTextTarget returns true if output is one of the targets of
+// Options.OutputTarget, which take lines of text.
+func isTextTarget(output string) bool {
+	return output == outputTargetJournald || strings.HasPrefix(output, outputTargetEventLog) || isSyslogTarget(output)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetOutputEncoding(t *testing.T) {
+	var buf bytes.Buffer
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	t.Run("cbor", func(t *testing.T) {
+		testLogger.SetOutputEncoding(OutputEncodingCBOR)
+		testLogger.Info("binary")
+
+		entries, err := DecodeCBOREntries(&buf)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "binary", entries[0][logFieldMessage])
+	})
+
+	t.Run("text restores the JSON output", func(t *testing.T) {
+		testLogger.SetOutputEncoding(OutputEncodingText)
+		testLogger.Info("json")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "json", o[logFieldMessage])
+		buf.Reset()
+	})
+
+	t.Run("sink", func(t *testing.T) {
+		var sinkBuf bytes.Buffer
+		testLogger.AddSink(&sinkBuf, SinkOptions{Encoding: OutputEncodingCBOR})
+		testLogger.Info("to the sink")
+
+		entries, err := DecodeCBOREntries(&sinkBuf)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "to the sink", entries[0][logFieldMessage])
+		assert.Contains(t, buf.String(), `"msg":"to the sink"`)
+	})
+}
+
+func TestValidateOptionsEncoding(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		for _, o := range []Options{
+			{},
+			{OutputEncoding: "text", OutputTarget: "journald"},
+			{OutputEncoding: "cbor", OutputFile: "/var/log/dapr.cbor"},
+			{Sinks: []OptionsSink{{Output: "stdout", SinkOptions: SinkOptions{Encoding: OutputEncodingCBOR}}}},
+			{Sinks: []OptionsSink{{Output: "journald", SinkOptions: SinkOptions{Colors: true}}}},
+		} {
+			_, err := validateOptionsEncoding(&o)
+			require.NoError(t, err, o)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, o := range []Options{
+			{OutputEncoding: "msgpack"},
+			{OutputEncoding: "cbor", OutputTarget: "syslog://127.0.0.1:514"},
+			{OutputEncoding: "cbor", OutputTarget: "journald"},
+			{OutputEncoding: "cbor", OutputTarget: "eventlog://dapr"},
+			{Sinks: []OptionsSink{{Output: "stdout", SinkOptions: SinkOptions{Encoding: "msgpack"}}}},
+			{Sinks: []OptionsSink{{Output: "stdout", SinkOptions: SinkOptions{Encoding: OutputEncodingCBOR, Colors: true}}}},
+			{Sinks: []OptionsSink{{Output: "journald", SinkOptions: SinkOptions{Encoding: OutputEncodingCBOR}}}},
+			{Sinks: []OptionsSink{{Output: "syslog://127.0.0.1:514", SinkOptions: SinkOptions{Encoding: OutputEncodingCBOR}}}},
+		} {
+			_, err := validateOptionsEncoding(&o)
+			require.Error(t, err, o)
+		}
+
+		opts := DefaultOptions()
+		opts.OutputEncoding = "cbor"
+		opts.OutputTarget = "journald"
+		require.ErrorContains(t, ApplyOptionsToLoggers(&opts), "OutputEncoding")
+	})
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	"github.com/sirupsen/logrus"
+)
+
+const logFieldPartition = "partition"
+
+// partitioning assigns entries to partitions from the hash of a field.
+type partitioning struct {
+	keyField      string
+	numPartitions int
+}
+
+// SetPartitioning adds to each entry a partition field, the FNV-1a hash of
+// the value of keyField modulo numPartitions, or -1 for the entries without
+// keyField. The partition of a value is stable across processes.
+// A numPartitions of zero or less disables it.
+func (l *daprLogger) SetPartitioning(keyField string, numPartitions int) {
+	if numPartitions <= 0 {
+		l.core.partitioning.Store(nil)
+		return
+	}
+
+	l.core.partitioning.Store(&partitioning{
+		keyField:      keyField,
+		numPartitions: numPartitions,
+	})
+}
+
+// partition returns the partition of the entry with data.
+func (p *partitioning) partition(data logrus.Fields) int {
+	v, ok := data[p.keyField]
+	if !ok {
+		return -1
+	}
+
+	h := fnv.New32a()
+	fmt.Fprint(h, v)
+
+	return int(h.Sum32() % uint32(p.numPartitions)) //nolint:gosec
+}
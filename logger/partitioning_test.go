@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetPartitioning(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.SetPartitioning("actor_id", 8)
+
+	partitionOf := func(l Logger) any {
+		l.Info("hello")
+
+		o := readTestEntry(t, &buf)
+
+		return o[logFieldPartition]
+	}
+
+	t.Run("stable partition", func(t *testing.T) {
+		p := partitionOf(testLogger.WithFields(map[string]any{"actor_id": "order-42"}))
+
+		// FNV-1a of "order-42" modulo 8.
+		assert.InDelta(t, float64(4), p, 0)
+		for range 3 {
+			assert.Equal(t, p, partitionOf(testLogger.WithFields(map[string]any{"actor_id": "order-42"})))
+		}
+	})
+
+	t.Run("partition range", func(t *testing.T) {
+		for _, id := range []any{"a", "b", "c", 42, 3.5} {
+			p := partitionOf(testLogger.WithFields(map[string]any{"actor_id": id}))
+			assert.GreaterOrEqual(t, p, float64(0))
+			assert.Less(t, p, float64(8))
+		}
+	})
+
+	t.Run("missing key field", func(t *testing.T) {
+		assert.InDelta(t, float64(-1), partitionOf(testLogger), 0)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		testLogger.SetPartitioning("actor_id", 0)
+		assert.Nil(t, partitionOf(testLogger))
+	})
+}
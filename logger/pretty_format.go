@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// prettyTimestampFormat is the compact layout of the time of the pretty
+	// text output.
+	prettyTimestampFormat = "15:04:05.000"
+	// prettyScopeWidth is the width the scopes are padded to, so the
+	// messages are aligned.
+	prettyScopeWidth = 20
+
+	// noColorEnvVar disables the colors when set, unless forced, see
+	// https://no-color.org.
+	noColorEnvVar = "NO_COLOR"
+)
+
+// The ANSI escape codes of the pretty text output.
+const (
+	ansiReset   = "\x1b[0m"
+	ansiFaint   = "\x1b[2m"
+	ansiRed     = "\x1b[31m"
+	ansiGreen   = "\x1b[32m"
+	ansiYellow  = "\x1b[33m"
+	ansiBlue    = "\x1b[34m"
+	ansiMagenta = "\x1b[35m"
+	ansiGray    = "\x1b[90m"
+)
+
+// prettyHiddenFields are the envelope fields left out of the pretty text
+// output, as they are the same for every entry of the process.
+var prettyHiddenFields = []string{logFieldScope, logFieldInstance, logFieldDaprVer, logFieldSchemaVer}
+
+// ForceColors enables the colorized, human-friendly text output even when the
+// output isn't a terminal. Otherwise, the text output is colorized only when
+// the output set with SetOutput is a terminal and the NO_COLOR environment
+// variable isn't set. It has no effect on the JSON output.
+func (l *daprLogger) ForceColors(enabled bool) {
+	l.core.forceColors.Store(enabled)
+
+	l.core.lock.Lock()
+	defer l.core.lock.Unlock()
+
+	if !isJSONFormatter(l.logger.Logger.Formatter) {
+		l.logger.Logger.SetFormatter(l.newFormatterLocked(false))
+	}
+}
+
+// colorsLocked returns true if the text output is colorized. The caller must
+// hold core.lock.
+func (l *daprLogger) colorsLocked() bool {
+	if l.core.forceColors.Load() {
+		return true
+	}
+
+	_, noColor := os.LookupEnv(noColorEnvVar)
+	return l.core.terminal && !noColor
+}
+
+// isTerminal returns true if w is a character device, such as a terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// prettyFormatter formats the entries as colorized text, with the time, level,
+// scope and message followed by the fields sorted by key.
+type prettyFormatter struct{}
+
+// Format implements logrus.Formatter.
+func (f *prettyFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	var buf bytes.Buffer
+
+	lvl := fromLogrusLevel(entry.Level)
+	scope, _ := entry.Data[logFieldScope].(string)
+
+	buf.WriteString(ansiFaint + entry.Time.Format(prettyTimestampFormat) + ansiReset + " ")
+	fmt.Fprintf(&buf, "%s%-5s%s ", prettyLevelColor(lvl), strings.ToUpper(string(lvl)), ansiReset)
+	fmt.Fprintf(&buf, "%s%-*s%s ", ansiFaint, prettyScopeWidth, scope, ansiReset)
+	buf.WriteString(entry.Message)
+
+	keys := make([]string, 0, len(entry.Data))
+	for k := range entry.Data {
+		if slices.Contains(prettyHiddenFields, k) || (k == logFieldType && entry.Data[k] == LogTypeLog) {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		buf.WriteString(" " + ansiFaint + k + "=" + ansiReset + prettyValue(entry.Data[k]))
+	}
+	buf.WriteByte('\n')
+
+	return buf.Bytes(), nil
+}
+
+// prettyValue returns v as text, quoted if it has spaces.
+func prettyValue(v any) string {
+	var s string
+	switch v := v.(type) {
+	case string:
+		s = v
+	case error:
+		s = v.Error()
+	default:
+		s = fmt.Sprint(v)
+	}
+
+	if s == "" || strings.ContainsAny(s, " \t\n\"=") {
+		return strconv.Quote(s)
+	}
+
+	return s
+}
+
+// prettyLevelColor returns the ANSI color of a level.
+func prettyLevelColor(lvl LogLevel) string {
+	switch lvl {
+	case TraceLevel:
+		return ansiGray
+	case DebugLevel:
+		return ansiBlue
+	case InfoLevel:
+		return ansiGreen
+	case WarnLevel:
+		return ansiYellow
+	case ErrorLevel:
+		return ansiRed
+	default:
+		return ansiMagenta
+	}
+}
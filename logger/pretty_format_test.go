@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestPrettyFormat(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	t.Run("disabled if the output isn't a terminal", func(t *testing.T) {
+		var buf bytes.Buffer
+		testLogger := getTestLogger(&buf)
+
+		assert.IsType(t, &logrus.TextFormatter{}, testLogger.logger.Logger.Formatter)
+		assert.False(t, isTerminal(&buf))
+	})
+
+	t.Run("forced colors", func(t *testing.T) {
+		var buf bytes.Buffer
+		testLogger := getTestLogger(&buf)
+		testLogger.core.clock = clocktesting.NewFakeClock(now)
+		testLogger.ForceColors(true)
+		assert.False(t, isJSONEnabled(testLogger))
+
+		testLogger.WithError(errors.New("boom")).
+			WithFields(map[string]any{"component": "state store"}).
+			Warn("failed to save state")
+
+		assert.Equal(t,
+			ansiFaint+"12:30:00.000"+ansiReset+" "+
+				ansiYellow+"WARN "+ansiReset+" "+
+				ansiFaint+"fakeLogger          "+ansiReset+" failed to save state"+
+				" "+ansiFaint+"component="+ansiReset+`"state store"`+
+				" "+ansiFaint+"error="+ansiReset+"boom"+
+				" "+ansiFaint+"error_type="+ansiReset+"*errors.errorString\n",
+			buf.String())
+
+		// JSON output is never colorized.
+		testLogger.EnableJSONOutput(true)
+		assert.True(t, isJSONEnabled(testLogger))
+
+		testLogger.EnableJSONOutput(false)
+		testLogger.ForceColors(false)
+		assert.IsType(t, &logrus.TextFormatter{}, testLogger.logger.Logger.Formatter)
+	})
+
+	t.Run("NO_COLOR disables the terminal colors", func(t *testing.T) {
+		testLogger := getTestLogger(&bytes.Buffer{})
+		testLogger.core.lock.Lock()
+		defer testLogger.core.lock.Unlock()
+
+		testLogger.core.terminal = true
+		assert.True(t, testLogger.colorsLocked())
+
+		t.Setenv(noColorEnvVar, "1")
+		assert.False(t, testLogger.colorsLocked())
+	})
+}
+
+func TestIsTerminal(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "out")
+	assert.NoError(t, err)
+	defer f.Close()
+
+	assert.False(t, isTerminal(f))
+}
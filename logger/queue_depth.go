@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"math"
+)
+
+const (
+	logFieldQueueName     = "queue_name"
+	logFieldQueueDepth    = "queue_depth"
+	logFieldQueueCapacity = "queue_capacity"
+	logFieldQueuePercent  = "queue_pct"
+)
+
+// DefaultQueueHighWaterMark is the default percentage of the capacity of a
+// queue above which LogQueueDepth logs at level Warn.
+const DefaultQueueHighWaterMark = 80.0
+
+// SetQueueHighWaterMark sets the percentage of the capacity of a queue, from 0
+// to 100, above which LogQueueDepth logs at level Warn.
+func (l *daprLogger) SetQueueHighWaterMark(pct float64) {
+	l.core.queueHighWaterMark.Store(math.Float64bits(pct))
+}
+
+// LogQueueDepth logs the depth of queue with the percentage of its capacity
+// in use in queue_pct, at level Warn above the high-water mark and at level
+// Debug otherwise. A capacity of zero or less is for unbounded queues, which
+// are logged without queue_pct at level Debug.
+func (l *daprLogger) LogQueueDepth(queue string, depth, capacity int) {
+	fields := map[string]any{
+		logFieldQueueName:     queue,
+		logFieldQueueDepth:    depth,
+		logFieldQueueCapacity: capacity,
+	}
+
+	if capacity <= 0 {
+		l.WithFields(fields).Debugf("Queue %s has %d items", queue, depth)
+		return
+	}
+
+	pct := float64(depth) / float64(capacity) * 100
+	fields[logFieldQueuePercent] = pct
+
+	if pct > math.Float64frombits(l.core.queueHighWaterMark.Load()) {
+		l.WithFields(fields).Warnf("Queue %s is at %.1f%% of its capacity (%d/%d)", queue, pct, depth, capacity)
+		return
+	}
+
+	l.WithFields(fields).Debugf("Queue %s is at %.1f%% of its capacity (%d/%d)", queue, pct, depth, capacity)
+}
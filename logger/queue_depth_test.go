@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogQueueDepth(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.SetOutputLevel(DebugLevel)
+
+	t.Run("below the default mark", func(t *testing.T) {
+		testLogger.LogQueueDepth("outbox", 50, 200)
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "debug", o[logFieldLevel])
+		assert.Equal(t, "outbox", o[logFieldQueueName])
+		assert.InDelta(t, float64(50), o[logFieldQueueDepth], 0)
+		assert.InDelta(t, float64(200), o[logFieldQueueCapacity], 0)
+		assert.InDelta(t, float64(25), o[logFieldQueuePercent], 1e-9)
+	})
+
+	t.Run("above the default mark", func(t *testing.T) {
+		testLogger.LogQueueDepth("outbox", 170, 200)
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "warning", o[logFieldLevel])
+		assert.InDelta(t, float64(85), o[logFieldQueuePercent], 1e-9)
+	})
+
+	t.Run("configured mark", func(t *testing.T) {
+		testLogger.SetQueueHighWaterMark(50)
+
+		testLogger.LogQueueDepth("outbox", 100, 200)
+		assert.Equal(t, "debug", readTestEntry(t, &buf)[logFieldLevel])
+
+		testLogger.LogQueueDepth("outbox", 101, 200)
+		assert.Equal(t, "warning", readTestEntry(t, &buf)[logFieldLevel])
+	})
+
+	t.Run("unbounded queue", func(t *testing.T) {
+		testLogger.LogQueueDepth("outbox", 1000, 0)
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "debug", o[logFieldLevel])
+		assert.NotContains(t, o, logFieldQueuePercent)
+	})
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"sync"
+)
+
+const (
+	logFieldComponent = "component"
+	logFieldReady     = "ready"
+	logFieldReason    = "reason"
+)
+
+// readinessStates holds the last readiness state logged per component.
+type readinessStates struct {
+	lock   sync.Mutex
+	states map[string]bool
+}
+
+// changed records the readiness of component, returning true if it differs
+// from the last recorded one.
+func (r *readinessStates) changed(component string, ready bool) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if prev, ok := r.states[component]; ok && prev == ready {
+		return false
+	}
+
+	if r.states == nil {
+		r.states = make(map[string]bool)
+	}
+	r.states[component] = ready
+
+	return true
+}
+
+// LogReadiness logs the readiness state of component, at level Info when ready
+// and at level Warn when not ready. Only state changes are logged: repeated
+// calls with the same state are suppressed.
+func (l *daprLogger) LogReadiness(component string, ready bool, reason string) {
+	if !l.core.readiness.changed(component, ready) {
+		return
+	}
+
+	log := l.WithFields(map[string]any{
+		logFieldComponent: component,
+		logFieldReady:     ready,
+		logFieldReason:    reason,
+	})
+
+	if ready {
+		log.Infof("%s is ready", component)
+		return
+	}
+
+	log.Warnf("%s is not ready: %s", component, reason)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogReadiness(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	testLogger.LogReadiness("statestore", false, "connecting")
+	testLogger.LogReadiness("statestore", false, "still connecting")
+
+	entries := readTestEntries(t, &buf)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "warning", entries[0][logFieldLevel])
+	assert.Equal(t, "statestore", entries[0][logFieldComponent])
+	assert.Equal(t, false, entries[0][logFieldReady])
+	assert.Equal(t, "connecting", entries[0][logFieldReason])
+
+	testLogger.LogReadiness("statestore", true, "connected")
+	testLogger.LogReadiness("statestore", true, "connected")
+	// Derived loggers share the readiness states.
+	testLogger.WithFields(map[string]any{"a": 1}).LogReadiness("statestore", true, "connected")
+
+	entries = readTestEntries(t, &buf)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "info", entries[0][logFieldLevel])
+	assert.Equal(t, true, entries[0][logFieldReady])
+	assert.Equal(t, "connected", entries[0][logFieldReason])
+
+	// Components are tracked independently.
+	testLogger.LogReadiness("pubsub", true, "connected")
+	assert.Len(t, readTestEntries(t, &buf), 1)
+}
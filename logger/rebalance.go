@@ -0,0 +1,38 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+const (
+	logFieldConsumerGroup      = "consumer_group"
+	logFieldPartitionsAssigned = "partitions_assigned"
+	logFieldPartitionsRevoked  = "partitions_revoked"
+)
+
+// LogRebalance logs at level Info the partitions assigned to and revoked from
+// the consumer group in a rebalance.
+func (l *daprLogger) LogRebalance(group string, assigned, revoked []int) {
+	// Log empty arrays rather than null.
+	if assigned == nil {
+		assigned = []int{}
+	}
+	if revoked == nil {
+		revoked = []int{}
+	}
+
+	l.WithFields(map[string]any{
+		logFieldConsumerGroup:      group,
+		logFieldPartitionsAssigned: assigned,
+		logFieldPartitionsRevoked:  revoked,
+	}).Infof("Consumer group %s rebalanced: %d partitions assigned, %d revoked", group, len(assigned), len(revoked))
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogRebalance(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	t.Run("partitions", func(t *testing.T) {
+		testLogger.LogRebalance("orders", []int{0, 2, 4}, []int{1})
+
+		assert.Contains(t, buf.String(), `"partitions_assigned":[0,2,4]`)
+		assert.Contains(t, buf.String(), `"partitions_revoked":[1]`)
+
+		o := readTestEntry(t, &buf)
+		buf.Reset()
+
+		assert.Equal(t, "info", o[logFieldLevel])
+		assert.Equal(t, "orders", o[logFieldConsumerGroup])
+	})
+
+	t.Run("no partitions", func(t *testing.T) {
+		testLogger.LogRebalance("orders", nil, []int{})
+
+		assert.Contains(t, buf.String(), `"partitions_assigned":[]`)
+		assert.Contains(t, buf.String(), `"partitions_revoked":[]`)
+	})
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/sirupsen/logrus"
+)
+
+// RedactedValue replaces the values removed by the built-in redactors.
+const RedactedValue = "[REDACTED]"
+
+// DefaultRedactedKeys are the field keys redacted by default. A key is also
+// redacted when it ends with one of them after an underscore, such as
+// db_password or access_token.
+var DefaultRedactedKeys = []string{
+	"password",
+	"passwd",
+	"secret",
+	"token",
+	"api_key",
+	"apikey",
+	"authorization",
+	"cookie",
+	"private_key",
+}
+
+// defaultRedactedPattern matches the bearer credentials redacted by default
+// in the values of the fields and the messages.
+var defaultRedactedPattern = regexp.MustCompile(`(?i)\bbearer\s+[a-z0-9\-._~+/]+=*`)
+
+// Redactor returns the value to log in place of the value of the field key,
+// and true if it redacted it. The messages are passed with the msg key.
+type Redactor func(key string, value any) (any, bool)
+
+var (
+	// redactors are applied in order to the fields of every entry. The slice
+	// is replaced, never modified, so it can be read without the lock.
+	redactors     atomic.Pointer[[]Redactor]
+	redactorsLock sync.Mutex
+)
+
+func init() {
+	SetRedactors(DefaultRedactors()...)
+}
+
+// DefaultRedactors returns the built-in redactors: one for the fields with
+// DefaultRedactedKeys and one for the bearer credentials in the values.
+func DefaultRedactors() []Redactor {
+	return []Redactor{
+		RedactKeys(DefaultRedactedKeys...),
+		RedactPattern(defaultRedactedPattern),
+	}
+}
+
+// RegisterRedactor adds r to the redactors applied to the fields and
+// messages of the entries of all loggers before they are written.
+func RegisterRedactor(r Redactor) {
+	redactorsLock.Lock()
+	defer redactorsLock.Unlock()
+
+	var rs []Redactor
+	if cur := redactors.Load(); cur != nil {
+		rs = append(rs, *cur...)
+	}
+	rs = append(rs, r)
+
+	redactors.Store(&rs)
+}
+
+// SetRedactors replaces all the redactors, including the built-in ones, with
+// rs. Passing no redactors disables the redaction.
+func SetRedactors(rs ...Redactor) {
+	redactorsLock.Lock()
+	defer redactorsLock.Unlock()
+
+	rs = append([]Redactor(nil), rs...)
+	redactors.Store(&rs)
+}
+
+// RedactKeys returns a Redactor replacing with RedactedValue the value of the
+// fields with one of keys, or ending with one of them after an underscore.
+// Keys are matched case-insensitively, with dashes matching underscores and
+// camelCase matching snake_case, so dbPassword matches password.
+func RedactKeys(keys ...string) Redactor {
+	normalized := make([]string, len(keys))
+	for i, k := range keys {
+		normalized[i] = normalizeRedactedKey(k)
+	}
+
+	return func(key string, _ any) (any, bool) {
+		key = normalizeRedactedKey(key)
+		for _, k := range normalized {
+			if key == k || strings.HasSuffix(key, "_"+k) {
+				return RedactedValue, true
+			}
+		}
+
+		return nil, false
+	}
+}
+
+func normalizeRedactedKey(key string) string {
+	return toSnakeCase(key)
+}
+
+// RedactPattern returns a Redactor replacing with RedactedValue the matches
+// of re in the string values.
+func RedactPattern(re *regexp.Regexp) Redactor {
+	return func(_ string, value any) (any, bool) {
+		s, ok := value.(string)
+		if !ok || !re.MatchString(s) {
+			return nil, false
+		}
+
+		return re.ReplaceAllLiteralString(s, RedactedValue), true
+	}
+}
+
+// redact applies the redactors to the fields and message of entry.
+func redact(entry *logrus.Entry) {
+	rs := redactors.Load()
+	if rs == nil || len(*rs) == 0 {
+		return
+	}
+
+	for k, v := range entry.Data {
+		entry.Data[k] = redactValue(*rs, k, v)
+	}
+
+	if v, ok := applyRedactors(*rs, logFieldMessage, entry.Message); ok {
+		if msg, ok := v.(string); ok {
+			entry.Message = msg
+		}
+	}
+}
+
+// redactValue returns value redacted, walking the nested maps and slices,
+// which are copied so the values of the caller aren't modified. The elements
+// of a slice are redacted as values of its key.
+func redactValue(rs []Redactor, key string, value any) any {
+	if v, ok := applyRedactors(rs, key, value); ok {
+		return v
+	}
+
+	switch nested := value.(type) {
+	case map[string]any:
+		return redactMap(rs, nested)
+	case logrus.Fields:
+		return logrus.Fields(redactMap(rs, nested))
+	case []any:
+		redacted := make([]any, len(nested))
+		for i, v := range nested {
+			redacted[i] = redactValue(rs, key, v)
+		}
+		return redacted
+	default:
+		return value
+	}
+}
+
+// redactMap returns a copy of m with its values redacted.
+func redactMap(rs []Redactor, m map[string]any) map[string]any {
+	redacted := make(map[string]any, len(m))
+	for k, v := range m {
+		redacted[k] = redactValue(rs, k, v)
+	}
+
+	return redacted
+}
+
+// applyRedactors returns the value of the first redactor redacting value.
+func applyRedactors(rs []Redactor, key string, value any) (any, bool) {
+	for _, r := range rs {
+		if v, ok := r(key, value); ok {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedaction(t *testing.T) {
+	t.Cleanup(func() { SetRedactors(DefaultRedactors()...) })
+
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	t.Run("built-in keys", func(t *testing.T) {
+		testLogger.WithFields(map[string]any{
+			"password":      "hunter2",
+			"DB-Password":   "hunter2",
+			"access_token":  "abc",
+			"Authorization": "Basic dXNlcjpwYXNz",
+			"token_count":   3,
+			"user":          "alice",
+		}).Info("login")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, RedactedValue, o["password"])
+		assert.Equal(t, RedactedValue, o["DB-Password"])
+		assert.Equal(t, RedactedValue, o["access_token"])
+		assert.Equal(t, RedactedValue, o["Authorization"])
+		assert.InDelta(t, 3, o["token_count"], 0)
+		assert.Equal(t, "alice", o["user"])
+	})
+
+	t.Run("nested fields are redacted without modifying them", func(t *testing.T) {
+		nested := map[string]any{"secret": "s3cr3t", "name": "db"}
+		testLogger.WithFields(map[string]any{"component": nested}).Info("init")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, map[string]any{"secret": RedactedValue, "name": "db"}, o["component"])
+		assert.Equal(t, "s3cr3t", nested["secret"])
+	})
+
+	t.Run("fields and slices are walked, and camelCase keys matched", func(t *testing.T) {
+		testLogger.WithFields(map[string]any{
+			"component": logrus.Fields{"dbPassword": "hunter2", "name": "db"},
+			"brokers":   []any{map[string]any{"apiKey": "k0"}, "Bearer abc"},
+		}).Info("init")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, map[string]any{"dbPassword": RedactedValue, "name": "db"}, o["component"])
+		assert.Equal(t, []any{map[string]any{"apiKey": RedactedValue}, RedactedValue}, o["brokers"])
+	})
+
+	t.Run("bearer credentials in values and messages", func(t *testing.T) {
+		testLogger.WithFields(map[string]any{
+			"header": "Bearer eyJhbGciOi.eyJzdWIiOi.SflKxwRJ",
+		}).Info("calling with bearer abc.def-ghi")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, RedactedValue, o["header"])
+		assert.Equal(t, "calling with "+RedactedValue, o[logFieldMessage])
+	})
+
+	t.Run("registered redactor", func(t *testing.T) {
+		RegisterRedactor(RedactPattern(regexp.MustCompile(`\d{4}-\d{4}-\d{4}-\d{4}`)))
+		RegisterRedactor(func(key string, value any) (any, bool) {
+			if key != "email" {
+				return nil, false
+			}
+			s, _ := value.(string)
+			return "***" + s[strings.Index(s, "@"):], true
+		})
+
+		testLogger.WithFields(map[string]any{
+			"card":     "card 1234-5678-9012-3456 declined",
+			"email":    "alice@example.com",
+			"password": "hunter2",
+		}).Info("payment")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "card "+RedactedValue+" declined", o["card"])
+		assert.Equal(t, "***@example.com", o["email"])
+		assert.Equal(t, RedactedValue, o["password"])
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		SetRedactors()
+
+		testLogger.WithFields(map[string]any{"password": "hunter2"}).Info("login")
+
+		assert.Equal(t, "hunter2", readTestEntry(t, &buf)["password"])
+	})
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"slices"
+	"strings"
+)
+
+// LoggerInfo describes a registered logger.
+type LoggerInfo struct {
+	// Name is the name the logger was created with
+	Name string
+	// Level is the current output level
+	Level LogLevel
+	// JSONEnabled is true if the logger outputs JSON
+	JSONEnabled bool
+}
+
+// List returns the description of all the loggers created with NewLogger,
+// sorted by name.
+func List() []LoggerInfo {
+	loggers := getLoggers()
+
+	infos := make([]LoggerInfo, 0, len(loggers))
+	for name, l := range loggers {
+		infos = append(infos, LoggerInfo{
+			Name:        name,
+			Level:       outputLevel(l),
+			JSONEnabled: isJSONEnabled(l),
+		})
+	}
+
+	slices.SortFunc(infos, func(a, b LoggerInfo) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return infos
+}
+
+// Get returns the logger created with NewLogger with the given name, and false
+// if there is none. Unlike NewLogger, it doesn't create the logger.
+func Get(name string) (Logger, bool) {
+	globalLoggersLock.RLock()
+	defer globalLoggersLock.RUnlock()
+
+	l, ok := globalLoggers[name]
+
+	return l, ok
+}
+
+func isJSONEnabled(l Logger) bool {
+	dl, ok := l.(*daprLogger)
+	if !ok {
+		return false
+	}
+
+	// The formatter is replaced under core.lock, such as by EnableJSONOutput.
+	dl.core.lock.Lock()
+	defer dl.core.lock.Unlock()
+
+	return isJSONFormatter(dl.logger.Logger.Formatter)
+}
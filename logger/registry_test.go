@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	a := NewLogger("registry.a")
+	b := NewLogger("registry.b")
+	t.Cleanup(func() {
+		a.SetOutputLevel(InfoLevel)
+		b.EnableJSONOutput(false)
+	})
+
+	a.SetOutputLevel(DebugLevel)
+	b.EnableJSONOutput(true)
+
+	t.Run("List", func(t *testing.T) {
+		var infos []LoggerInfo
+		for _, info := range List() {
+			if strings.HasPrefix(info.Name, "registry.") {
+				infos = append(infos, info)
+			}
+		}
+
+		assert.Equal(t, []LoggerInfo{
+			{Name: "registry.a", Level: DebugLevel, JSONEnabled: false},
+			{Name: "registry.b", Level: InfoLevel, JSONEnabled: true},
+		}, infos)
+
+		assert.True(t, slices.IsSortedFunc(List(), func(x, y LoggerInfo) int {
+			return strings.Compare(x.Name, y.Name)
+		}))
+	})
+
+	t.Run("List concurrently with EnableJSONOutput", func(t *testing.T) {
+		var wg sync.WaitGroup
+		wg.Go(func() {
+			for i := range 100 {
+				b.EnableJSONOutput(i%2 == 0)
+			}
+		})
+		for range 100 {
+			List()
+		}
+		wg.Wait()
+		b.EnableJSONOutput(true)
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		l, ok := Get("registry.a")
+		require.True(t, ok)
+		assert.Same(t, a, l)
+
+		l, ok = Get("registry.missing")
+		assert.False(t, ok)
+		assert.Nil(t, l)
+
+		for _, info := range List() {
+			assert.NotEqual(t, "registry.missing", info.Name)
+		}
+	})
+}
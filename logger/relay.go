@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	logFieldOrigin         = "origin"
+	logFieldOriginAppID    = "origin_app_id"
+	logFieldOriginInstance = "origin_instance"
+)
+
+// originFields are the fields of the Dapr log schema identifying the node of
+// a relayed entry, kept under their origin_* key.
+var originFields = map[string]string{
+	logFieldAppID:    logFieldOriginAppID,
+	logFieldInstance: logFieldOriginInstance,
+}
+
+// WithOrigin returns a logger whose entries carry the node they come from in
+// the origin field.
+func (l *daprLogger) WithOrigin(node string) Logger {
+	return l.derive(l.logger.WithField(logFieldOrigin, node))
+}
+
+// ParseEntry parses an entry formatted as JSON by a Dapr logger, expanding
+// its compact envelope if any. The level is UndefinedLevel if the line has
+// none.
+func ParseEntry(line []byte) (Entry, error) {
+	var fields map[string]any
+	if err := json.Unmarshal(line, &fields); err != nil {
+		return Entry{}, fmt.Errorf("failed to parse log entry: %w", err)
+	}
+
+	e := Entry{
+		Level:  UndefinedLevel,
+		Fields: ExpandCompactEnvelope(fields),
+	}
+	e.Scope, _ = fields[logFieldScope].(string)
+	e.Type, _ = fields[logFieldType].(string)
+	e.Message, _ = fields[logFieldMessage].(string)
+
+	if ts, ok := fields[logFieldTimeStamp].(string); ok {
+		t, err := time.Parse(time.RFC3339Nano, ts)
+		if err != nil {
+			return Entry{}, fmt.Errorf("failed to parse log entry time: %w", err)
+		}
+		e.Time = t
+	}
+
+	if lvl, ok := fields[logFieldLevel].(string); ok {
+		parsed, err := logrus.ParseLevel(lvl)
+		if err != nil {
+			return Entry{}, fmt.Errorf("failed to parse log entry level: %w", err)
+		}
+		e.Level = fromLogrusLevel(parsed)
+	}
+
+	return e, nil
+}
+
+// Relay re-emits e, such as an entry parsed with ParseEntry, at its level and
+// time with its custom fields. The origin of e, if any, is preserved over the
+// origin of the logger, and its app_id and instance are kept in the
+// origin_app_id and origin_instance fields. Relayed Fatal entries don't exit
+// the process, and entries without a level, empty or UndefinedLevel, are
+// relayed at level Info. Entries without a time are relayed at the current
+// time.
+func (l *daprLogger) Relay(e Entry) {
+	level := logrus.InfoLevel
+	if e.Level != "" && e.Level != UndefinedLevel {
+		level = toLogrusLevel(e.Level)
+	}
+
+	if !l.isLevelEnabled(level) {
+		return
+	}
+
+	fields := maps.Clone(e.Fields)
+	for k, origin := range originFields {
+		if v, ok := fields[k]; ok {
+			fields[origin] = v
+		}
+	}
+	maps.DeleteFunc(fields, func(k string, _ any) bool {
+		_, ok := standardFields[k]
+		return ok
+	})
+
+	at := e.Time
+	if at.IsZero() {
+		at = l.core.clock.Now()
+	}
+
+	l.derive(l.logger.WithFields(fields)).logAt(level, at, e.Message)
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestWithOrigin(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	t.Run("origin field", func(t *testing.T) {
+		testLogger.WithOrigin("node-a").Info("hello")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "node-a", o[logFieldOrigin])
+	})
+
+	t.Run("relay preserves the origin", func(t *testing.T) {
+		remoteLogger := getTestLogger(&buf)
+		remoteLogger.core.clock = clocktesting.NewFakeClock(time.Date(2026, 1, 2, 3, 4, 5, 6000, time.UTC))
+		remoteLogger.EnableJSONOutput(true)
+		remoteLogger.SetAppID("remote-app")
+		remoteLogger.WithOrigin("node-a").WithFields(map[string]any{"attempt": 3}).Warn("remote warning")
+
+		e, err := ParseEntry(buf.Bytes())
+		require.NoError(t, err)
+		buf.Reset()
+
+		assert.Equal(t, WarnLevel, e.Level)
+		assert.Equal(t, "remote warning", e.Message)
+		assert.Equal(t, fakeLoggerName, e.Scope)
+		assert.False(t, e.Time.IsZero())
+
+		testLogger.WithOrigin("proxy").Relay(e)
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "node-a", o[logFieldOrigin])
+		assert.Equal(t, "warning", o[logFieldLevel])
+		assert.Equal(t, "remote warning", o[logFieldMessage])
+		assert.InDelta(t, float64(3), o["attempt"], 0)
+		assert.Equal(t, "remote-app", o[logFieldOriginAppID])
+		assert.NotEmpty(t, o[logFieldOriginInstance])
+		assert.Equal(t, e.Fields[logFieldInstance], o[logFieldOriginInstance])
+		assert.NotContains(t, o, logFieldAppID)
+
+		relayed, err := time.Parse(time.RFC3339Nano, o[logFieldTimeStamp].(string))
+		require.NoError(t, err)
+		assert.True(t, e.Time.Equal(relayed), "relayed at %s instead of %s", relayed, e.Time)
+	})
+
+	t.Run("relay without level", func(t *testing.T) {
+		e, err := ParseEntry([]byte(`{"msg":"no level"}`))
+		require.NoError(t, err)
+		assert.Equal(t, UndefinedLevel, e.Level)
+
+		testLogger.Relay(e)
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "info", o[logFieldLevel])
+		assert.Equal(t, "no level", o[logFieldMessage])
+
+		testLogger.Relay(Entry{Message: "zero level"})
+		o = readTestEntry(t, &buf)
+		assert.Equal(t, "info", o[logFieldLevel])
+	})
+
+	t.Run("relay without origin", func(t *testing.T) {
+		e, err := ParseEntry([]byte(`{"level":"info","msg":"local","scope":"other"}`))
+		require.NoError(t, err)
+
+		testLogger.WithOrigin("proxy").Relay(e)
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "proxy", o[logFieldOrigin])
+		assert.Equal(t, fakeLoggerName, o[logFieldScope])
+	})
+}
+
+func TestParseEntry(t *testing.T) {
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := ParseEntry([]byte("not json"))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid level", func(t *testing.T) {
+		_, err := ParseEntry([]byte(`{"level":"loud"}`))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid time", func(t *testing.T) {
+		_, err := ParseEntry([]byte(`{"time":"yesterday"}`))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	kclock "k8s.io/utils/clock"
+)
+
+// SuppressRepeats writes the first of identical consecutive entries and drops
+// the ones logged within window after it. Once a different entry is logged or
+// the window elapses, a "last message repeated N times" entry with the number
+// of dropped entries in the repeat_count field is written, or "last message
+// repeated 1 time" for a single one.
+// Unlike EnableCoalescing, the first entry is written without delay. Fatal
+// entries are never suppressed.
+// A window of zero or less writes the pending summary and disables it.
+func (l *daprLogger) SuppressRepeats(window time.Duration) {
+	var s *repeatSuppressor
+	if window > 0 {
+		s = &repeatSuppressor{
+			clock:  l.core.clock,
+			window: window,
+			write:  l.write,
+		}
+	}
+
+	if prev := l.core.repeatSuppressor.Swap(s); prev != nil {
+		prev.flush(l.core.clock.Now())
+	}
+}
+
+// repeatSuppressor drops identical consecutive entries.
+type repeatSuppressor struct {
+	lock   sync.Mutex
+	clock  kclock.WithDelayedExecution
+	window time.Duration
+	write  func(*logrus.Entry)
+
+	last    *logrus.Entry
+	repeats int
+	timer   kclock.Timer
+}
+
+// allow returns true if e must be written, after writing the summary of the
+// entries suppressed before it, if any.
+func (s *repeatSuppressor) allow(e *logrus.Entry) bool {
+	s.lock.Lock()
+	if s.last != nil && s.timer != nil && sameEntry(s.last, e) {
+		s.repeats++
+		s.lock.Unlock()
+		return false
+	}
+
+	summary := s.takeLocked(e.Time)
+	s.last = e
+	s.timer = s.clock.AfterFunc(s.window, func() {
+		s.flushEntry(e)
+	})
+	s.lock.Unlock()
+
+	s.emit(summary)
+
+	return true
+}
+
+// flush writes the summary of the suppressed entries, if any, at now.
+func (s *repeatSuppressor) flush(now time.Time) {
+	s.lock.Lock()
+	summary := s.takeLocked(now)
+	s.last = nil
+	s.lock.Unlock()
+
+	s.emit(summary)
+}
+
+// flushEntry ends the window of e if it's still the last entry.
+func (s *repeatSuppressor) flushEntry(e *logrus.Entry) {
+	s.lock.Lock()
+	if s.last != e {
+		s.lock.Unlock()
+		return
+	}
+	// The timer already fired.
+	s.timer = nil
+	summary := s.takeLocked(e.Time.Add(s.window))
+	s.lock.Unlock()
+
+	s.emit(summary)
+}
+
+// takeLocked ends the window of the last entry and returns the summary of the
+// entries suppressed in it at now, or nil if none were.
+func (s *repeatSuppressor) takeLocked(now time.Time) *logrus.Entry {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+
+	repeats := s.repeats
+	s.repeats = 0
+	if s.last == nil || repeats == 0 {
+		return nil
+	}
+
+	summary := s.last.Dup()
+	summary.Time = now
+	summary.Level = s.last.Level
+	if repeats == 1 {
+		summary.Message = "last message repeated 1 time"
+	} else {
+		summary.Message = fmt.Sprintf("last message repeated %d times", repeats)
+	}
+	summary.Data[logFieldRepeatCount] = repeats
+
+	return summary
+}
+
+func (s *repeatSuppressor) emit(summary *logrus.Entry) {
+	if summary != nil {
+		s.write(summary)
+	}
+}
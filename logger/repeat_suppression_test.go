@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestSuppressRepeats(t *testing.T) {
+	t.Run("identical consecutive entries", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+		testLogger.SuppressRepeats(time.Hour)
+
+		for range 5 {
+			testLogger.Warn("reconnecting")
+		}
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "reconnecting", o[logFieldMessage])
+		assert.NotContains(t, o, logFieldRepeatCount)
+		assert.Empty(t, buf.Bytes())
+
+		testLogger.Info("connected")
+
+		o = readTestEntry(t, &buf)
+		assert.Equal(t, "last message repeated 4 times", o[logFieldMessage])
+		assert.Equal(t, "warning", o[logFieldLevel])
+		assert.InDelta(t, float64(4), o[logFieldRepeatCount], 0)
+
+		o = readTestEntry(t, &buf)
+		assert.Equal(t, "connected", o[logFieldMessage])
+		assert.Empty(t, buf.Bytes())
+	})
+
+	t.Run("different fields are not repeats", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+		testLogger.SuppressRepeats(time.Hour)
+
+		testLogger.WithFields(map[string]any{"attempt": 1}).Warn("reconnecting")
+		testLogger.WithFields(map[string]any{"attempt": 2}).Warn("reconnecting")
+
+		assert.Equal(t, 2, strings.Count(buf.String(), "\n"))
+	})
+
+	t.Run("summary after the window", func(t *testing.T) {
+		var buf syncBuffer
+
+		clock := clocktesting.NewFakeClock(time.Now())
+		testLogger := getTestLogger(&buf)
+		testLogger.core.clock = clock
+		testLogger.EnableJSONOutput(true)
+		testLogger.SuppressRepeats(time.Second)
+
+		testLogger.Error("failed")
+		testLogger.Error("failed")
+		testLogger.Error("failed")
+		assert.Equal(t, 1, strings.Count(buf.String(), "\n"))
+
+		clock.Step(time.Second)
+
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			assert.Contains(c, buf.String(), "last message repeated 2 times")
+		}, 5*time.Second, 10*time.Millisecond)
+
+		// A new window starts with the next entry.
+		testLogger.Error("failed")
+		assert.Equal(t, 3, strings.Count(buf.String(), "\n"))
+	})
+
+	t.Run("single repeat", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+		testLogger.SuppressRepeats(time.Hour)
+
+		testLogger.Warn("reconnecting")
+		testLogger.Warn("reconnecting")
+		testLogger.Info("connected")
+
+		assert.Equal(t, "reconnecting", readTestEntry(t, &buf)[logFieldMessage])
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "last message repeated 1 time", o[logFieldMessage])
+		assert.InDelta(t, float64(1), o[logFieldRepeatCount], 0)
+	})
+
+	t.Run("fatal entries write the summary", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+		testLogger.SuppressRepeats(time.Hour)
+
+		testLogger.Error("failed")
+		testLogger.Error("failed")
+		testLogger.Fatal("giving up")
+
+		assert.Equal(t, "failed", readTestEntry(t, &buf)[logFieldMessage])
+		assert.Equal(t, "last message repeated 1 time", readTestEntry(t, &buf)[logFieldMessage])
+		assert.Equal(t, "giving up", readTestEntry(t, &buf)[logFieldMessage])
+	})
+
+	t.Run("disabling writes the summary", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+		testLogger.SuppressRepeats(time.Hour)
+
+		testLogger.Info("tick")
+		testLogger.Info("tick")
+		testLogger.SuppressRepeats(0)
+		testLogger.Info("tick")
+
+		assert.Equal(t, "tick", readTestEntry(t, &buf)[logFieldMessage])
+		assert.Equal(t, "last message repeated 1 time", readTestEntry(t, &buf)[logFieldMessage])
+		assert.Equal(t, "tick", readTestEntry(t, &buf)[logFieldMessage])
+	})
+}
@@ -0,0 +1,385 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	kclock "k8s.io/utils/clock"
+)
+
+// backupTimeFormat is the format of the time in the name of the backups.
+const backupTimeFormat = "2006-01-02T15-04-05.000"
+
+const compressSuffix = ".gz"
+
+// RotatingFileOptions configures a RotatingFileWriter.
+type RotatingFileOptions struct {
+	// Filename is the file the logs are written to. Its directory must exist.
+	Filename string
+	// MaxSizeMB is the size in megabytes the file is rotated at. It's not
+	// rotated if zero.
+	MaxSizeMB int
+	// MaxAge is how long the backups are kept. They are kept forever if zero.
+	MaxAge time.Duration
+	// MaxBackups is the number of backups kept. All are kept if zero.
+	MaxBackups int
+	// Compress enables gzip-compressing the backups.
+	Compress bool
+}
+
+// RotatingFileWriter writes to a file, which is rotated once it reaches a
+// maximum size. The rotated file is renamed with the rotation time, for
+// example app.log becomes app-2026-03-01T12-00-00.000.log, suffixed with -1,
+// -2... if several rotations happen in the same millisecond, and becomes a
+// backup, which can be compressed and is removed once too old or too many.
+// The backups are compressed and removed in the background.
+type RotatingFileWriter struct {
+	lock    sync.Mutex
+	opts    RotatingFileOptions
+	maxSize int64
+	clock   kclock.PassiveClock
+	file    *os.File
+	size    int64
+	// closed is true once Close is called, while file is also nil when
+	// reopening the file failed after a rotation
+	closed bool
+
+	// cleanupLock serializes the background cleanups of the backups.
+	cleanupLock sync.Mutex
+	cleanupWG   sync.WaitGroup
+}
+
+// NewRotatingFileWriter returns a RotatingFileWriter, opening the file to
+// append to it.
+func NewRotatingFileWriter(opts RotatingFileOptions) (*RotatingFileWriter, error) {
+	return newRotatingFileWriter(opts, kclock.RealClock{})
+}
+
+func newRotatingFileWriter(opts RotatingFileOptions, clock kclock.PassiveClock) (*RotatingFileWriter, error) {
+	if opts.Filename == "" {
+		return nil, errors.New("log file name is empty")
+	}
+
+	w := &RotatingFileWriter{
+		opts:    opts,
+		maxSize: int64(opts.MaxSizeMB) * 1024 * 1024,
+		clock:   clock,
+	}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// Write writes p to the file, rotating it first if p doesn't fit in it.
+func (w *RotatingFileWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if err := w.reopenIfLost(); err != nil {
+		return 0, err
+	}
+
+	if w.maxSize > 0 && w.size > 0 && w.size+int64(len(p)) > w.maxSize {
+		if err := w.rotate(); err != nil {
+			if w.file == nil {
+				return 0, err
+			}
+			// The file was reopened, so p isn't lost.
+			fmt.Fprintf(os.Stderr, "Failed to rotate log file, %v\n", err)
+		}
+	}
+
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+
+	return n, err
+}
+
+// Rotate rotates the file, even if it didn't reach its maximum size. The
+// failures to clean the backups up are printed to stderr.
+func (w *RotatingFileWriter) Rotate() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if err := w.reopenIfLost(); err != nil {
+		return err
+	}
+
+	return w.rotate()
+}
+
+// Sync commits the writes to the file to stable storage.
+func (w *RotatingFileWriter) Sync() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if err := w.reopenIfLost(); err != nil {
+		return err
+	}
+
+	return w.file.Sync()
+}
+
+// Close closes the file, after the backups being cleaned up.
+func (w *RotatingFileWriter) Close() error {
+	w.lock.Lock()
+	closed, f := w.closed, w.file
+	w.closed = true
+	w.file = nil
+	w.lock.Unlock()
+
+	w.cleanupWG.Wait()
+
+	if closed || f == nil {
+		return nil
+	}
+
+	return f.Close()
+}
+
+// reopenIfLost opens the file again if a rotation failed to reopen it, and
+// returns ErrWriterClosed once closed. The caller must hold lock.
+func (w *RotatingFileWriter) reopenIfLost() error {
+	if w.closed {
+		return ErrWriterClosed
+	}
+	if w.file == nil {
+		return w.open()
+	}
+
+	return nil
+}
+
+func (w *RotatingFileWriter) open() error {
+	f, err := os.OpenFile(w.opts.Filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("failed to stat log file: %w", err)
+	}
+
+	w.file = f
+	w.size = info.Size()
+
+	return nil
+}
+
+// rotate renames the file to a new backup and opens a new file, then cleans up
+// the backups in the background. If closing or renaming the file fails, the
+// original file is reopened. The caller must hold lock.
+func (w *RotatingFileWriter) rotate() error {
+	err := w.file.Close()
+	w.file = nil
+	if err != nil {
+		err = fmt.Errorf("failed to close log file: %w", err)
+	} else if rerr := os.Rename(w.opts.Filename, w.backupName()); rerr != nil {
+		err = fmt.Errorf("failed to rename log file: %w", rerr)
+	}
+
+	if oerr := w.open(); oerr != nil {
+		return errors.Join(err, oerr)
+	}
+	if err != nil {
+		return err
+	}
+
+	w.cleanupWG.Go(func() {
+		w.cleanupLock.Lock()
+		defer w.cleanupLock.Unlock()
+
+		if err := w.cleanBackups(); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to clean up log backups, %v\n", err)
+		}
+	})
+
+	return nil
+}
+
+// backupName returns the path of a new backup, named after the current time
+// and suffixed with a sequence number if a backup of the same millisecond
+// exists.
+func (w *RotatingFileWriter) backupName() string {
+	dir, prefix, ext := w.backupParts()
+	base := prefix + w.clock.Now().UTC().Format(backupTimeFormat)
+
+	name := filepath.Join(dir, base+ext)
+	for seq := 1; backupExists(name); seq++ {
+		name = filepath.Join(dir, base+"-"+strconv.Itoa(seq)+ext)
+	}
+
+	return name
+}
+
+// backupExists returns true if the backup at path exists, compressed or not.
+func backupExists(path string) bool {
+	for _, p := range []string{path, path + compressSuffix} {
+		if _, err := os.Lstat(p); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backupParts returns the directory of the backups and the prefix and
+// extension of their names.
+func (w *RotatingFileWriter) backupParts() (dir, prefix, ext string) {
+	dir = filepath.Dir(w.opts.Filename)
+	name := filepath.Base(w.opts.Filename)
+	ext = filepath.Ext(name)
+
+	return dir, strings.TrimSuffix(name, ext) + "-", ext
+}
+
+type logBackup struct {
+	path string
+	time time.Time
+	// seq orders the backups of the same millisecond
+	seq int
+}
+
+// cleanBackups compresses the backups and removes the old ones.
+func (w *RotatingFileWriter) cleanBackups() error {
+	backups, err := w.listBackups()
+	if err != nil {
+		return err
+	}
+
+	// Newest first.
+	slices.SortFunc(backups, func(a, b logBackup) int {
+		if c := b.time.Compare(a.time); c != 0 {
+			return c
+		}
+		return b.seq - a.seq
+	})
+
+	cutoff := w.clock.Now().Add(-w.opts.MaxAge)
+
+	var errs []error
+	for i, b := range backups {
+		if (w.opts.MaxBackups > 0 && i >= w.opts.MaxBackups) || (w.opts.MaxAge > 0 && b.time.Before(cutoff)) {
+			if err := os.Remove(b.path); err != nil {
+				errs = append(errs, fmt.Errorf("failed to remove log backup: %w", err))
+			}
+			continue
+		}
+
+		if w.opts.Compress && !strings.HasSuffix(b.path, compressSuffix) {
+			if err := compressFile(b.path); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (w *RotatingFileWriter) listBackups() ([]logBackup, error) {
+	dir, prefix, ext := w.backupParts()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list log backups: %w", err)
+	}
+
+	var backups []logBackup
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		ts := strings.TrimPrefix(name, prefix)
+		ts = strings.TrimSuffix(ts, compressSuffix)
+		if !strings.HasSuffix(ts, ext) {
+			continue
+		}
+
+		ts = strings.TrimSuffix(ts, ext)
+		if len(ts) < len(backupTimeFormat) {
+			continue
+		}
+
+		t, err := time.Parse(backupTimeFormat, ts[:len(backupTimeFormat)])
+		if err != nil {
+			continue
+		}
+
+		var seq int
+		if rest := ts[len(backupTimeFormat):]; rest != "" {
+			if seq, err = strconv.Atoi(strings.TrimPrefix(rest, "-")); err != nil || !strings.HasPrefix(rest, "-") {
+				continue
+			}
+		}
+
+		backups = append(backups, logBackup{
+			path: filepath.Join(dir, name),
+			time: t,
+			seq:  seq,
+		})
+	}
+
+	return backups, nil
+}
+
+// compressFile replaces the file at path with its gzip-compressed version.
+func compressFile(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open log backup: %w", err)
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(path+compressSuffix, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create compressed log backup: %w", err)
+	}
+
+	gz := gzip.NewWriter(dst)
+	_, err = io.Copy(gz, src)
+	if err == nil {
+		err = gz.Close()
+	}
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(path + compressSuffix)
+		return fmt.Errorf("failed to compress log backup: %w", err)
+	}
+
+	src.Close()
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("failed to remove log backup: %w", err)
+	}
+
+	return nil
+}
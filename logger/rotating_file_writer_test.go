@@ -0,0 +1,208 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestRotatingFileWriter(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	newWriter := func(t *testing.T, opts RotatingFileOptions) (*RotatingFileWriter, *clocktesting.FakePassiveClock) {
+		t.Helper()
+
+		clock := clocktesting.NewFakePassiveClock(start)
+		opts.Filename = filepath.Join(t.TempDir(), "app.log")
+
+		w, err := newRotatingFileWriter(opts, clock)
+		require.NoError(t, err)
+		t.Cleanup(func() { w.Close() })
+
+		// Rotate at 10 bytes.
+		w.maxSize = 10
+
+		return w, clock
+	}
+
+	// write writes each line one second apart.
+	write := func(t *testing.T, w *RotatingFileWriter, clock *clocktesting.FakePassiveClock, lines ...string) {
+		t.Helper()
+
+		for _, line := range lines {
+			clock.SetTime(clock.Now().Add(time.Second))
+			_, err := w.Write([]byte(line))
+			require.NoError(t, err)
+		}
+	}
+
+	files := func(t *testing.T, w *RotatingFileWriter) []string {
+		t.Helper()
+
+		// The backups are cleaned up in the background.
+		w.cleanupWG.Wait()
+
+		entries, err := os.ReadDir(filepath.Dir(w.opts.Filename))
+		require.NoError(t, err)
+
+		var names []string
+		for _, e := range entries {
+			names = append(names, e.Name())
+		}
+		slices.Sort(names)
+
+		return names
+	}
+
+	readFile := func(t *testing.T, path string) string {
+		t.Helper()
+
+		b, err := os.ReadFile(path)
+		require.NoError(t, err)
+
+		return string(b)
+	}
+
+	t.Run("rotates at max size", func(t *testing.T) {
+		w, clock := newWriter(t, RotatingFileOptions{})
+		write(t, w, clock, "first\n", "second\n", "third\n")
+
+		assert.Equal(t, []string{
+			"app-2026-03-01T12-00-02.000.log",
+			"app-2026-03-01T12-00-03.000.log",
+			"app.log",
+		}, files(t, w))
+
+		dir := filepath.Dir(w.opts.Filename)
+		assert.Equal(t, "first\n", readFile(t, filepath.Join(dir, "app-2026-03-01T12-00-02.000.log")))
+		assert.Equal(t, "second\n", readFile(t, filepath.Join(dir, "app-2026-03-01T12-00-03.000.log")))
+		assert.Equal(t, "third\n", readFile(t, w.opts.Filename))
+	})
+
+	t.Run("max backups", func(t *testing.T) {
+		w, clock := newWriter(t, RotatingFileOptions{MaxBackups: 1})
+		write(t, w, clock, "first\n", "second\n", "third\n")
+
+		assert.Equal(t, []string{
+			"app-2026-03-01T12-00-03.000.log",
+			"app.log",
+		}, files(t, w))
+	})
+
+	t.Run("max age", func(t *testing.T) {
+		w, clock := newWriter(t, RotatingFileOptions{MaxAge: time.Hour})
+		write(t, w, clock, "first\n", "second\n")
+
+		clock.SetTime(clock.Now().Add(2 * time.Hour))
+		write(t, w, clock, "third\n")
+
+		assert.Equal(t, []string{
+			"app-2026-03-01T14-00-03.000.log",
+			"app.log",
+		}, files(t, w))
+	})
+
+	t.Run("compress", func(t *testing.T) {
+		w, clock := newWriter(t, RotatingFileOptions{Compress: true})
+		write(t, w, clock, "first\n", "second\n")
+
+		assert.Equal(t, []string{
+			"app-2026-03-01T12-00-02.000.log.gz",
+			"app.log",
+		}, files(t, w))
+
+		f, err := os.Open(filepath.Join(filepath.Dir(w.opts.Filename), "app-2026-03-01T12-00-02.000.log.gz"))
+		require.NoError(t, err)
+		defer f.Close()
+
+		gz, err := gzip.NewReader(f)
+		require.NoError(t, err)
+
+		b, err := io.ReadAll(gz)
+		require.NoError(t, err)
+		assert.Equal(t, "first\n", string(b))
+	})
+
+	t.Run("rotations in the same millisecond", func(t *testing.T) {
+		w, _ := newWriter(t, RotatingFileOptions{MaxBackups: 3})
+		for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n", "fifth\n"} {
+			_, err := w.Write([]byte(line))
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, []string{
+			"app-2026-03-01T12-00-00.000-1.log",
+			"app-2026-03-01T12-00-00.000-2.log",
+			"app-2026-03-01T12-00-00.000-3.log",
+			"app.log",
+		}, files(t, w))
+
+		dir := filepath.Dir(w.opts.Filename)
+		assert.Equal(t, "fourth\n", readFile(t, filepath.Join(dir, "app-2026-03-01T12-00-00.000-3.log")))
+		assert.Equal(t, "fifth\n", readFile(t, w.opts.Filename))
+	})
+
+	t.Run("reopens the file if the rotation fails", func(t *testing.T) {
+		w, clock := newWriter(t, RotatingFileOptions{})
+		write(t, w, clock, "first\n")
+
+		// Renaming the removed file fails.
+		require.NoError(t, os.Remove(w.opts.Filename))
+		write(t, w, clock, "second\n", "third\n")
+
+		assert.Equal(t, []string{
+			"app-2026-03-01T12-00-03.000.log",
+			"app.log",
+		}, files(t, w))
+		assert.Equal(t, "third\n", readFile(t, w.opts.Filename))
+	})
+
+	t.Run("appends to the existing file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "app.log")
+		require.NoError(t, os.WriteFile(path, []byte("old\n"), 0o600))
+
+		w, err := NewRotatingFileWriter(RotatingFileOptions{Filename: path, MaxSizeMB: 1})
+		require.NoError(t, err)
+
+		_, err = w.Write([]byte("new\n"))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		assert.Equal(t, "old\nnew\n", readFile(t, path))
+
+		_, err = w.Write([]byte("closed\n"))
+		require.ErrorIs(t, err, ErrWriterClosed)
+	})
+
+	t.Run("logger output", func(t *testing.T) {
+		w, _ := newWriter(t, RotatingFileOptions{})
+		w.maxSize = 0
+
+		testLogger := newDaprLogger("rotatingLogger")
+		testLogger.SetOutput(w)
+		testLogger.Info("to file")
+
+		assert.Contains(t, readFile(t, w.opts.Filename), "to file")
+	})
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"runtime"
+)
+
+const (
+	logFieldHeapAlloc   = "heap_alloc"
+	logFieldHeapObjects = "heap_objects"
+	logFieldNumGC       = "num_gc"
+	logFieldGoroutines  = "goroutines"
+)
+
+// LogRuntimeStats logs at level Debug a snapshot of the memory and GC stats.
+// The stats are only read when level Debug is enabled.
+func (l *daprLogger) LogRuntimeStats() {
+	if !l.IsOutputLevelEnabled(DebugLevel) {
+		return
+	}
+
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+
+	l.WithFields(map[string]any{
+		logFieldHeapAlloc:   ms.HeapAlloc,
+		logFieldHeapObjects: ms.HeapObjects,
+		logFieldNumGC:       ms.NumGC,
+		logFieldGoroutines:  runtime.NumGoroutine(),
+	}).Debug("Runtime stats")
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogRuntimeStats(t *testing.T) {
+	t.Run("debug enabled", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+		testLogger.SetOutputLevel(DebugLevel)
+
+		testLogger.LogRuntimeStats()
+
+		o := readTestEntry(t, &buf)
+
+		assert.Equal(t, "debug", o[logFieldLevel])
+		for _, k := range []string{logFieldHeapAlloc, logFieldHeapObjects, logFieldNumGC, logFieldGoroutines} {
+			assert.IsTypef(t, float64(0), o[k], "field %s", k)
+		}
+		assert.Positive(t, o[logFieldHeapAlloc])
+		assert.Positive(t, o[logFieldGoroutines])
+	})
+
+	t.Run("debug disabled", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.SetOutputLevel(InfoLevel)
+
+		testLogger.LogRuntimeStats()
+
+		assert.Empty(t, buf.Bytes())
+	})
+}
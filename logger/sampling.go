@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"math/rand/v2"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+const logFieldSampled = "sampled"
+
+// sampler keeps entries at random with a probability of rate.
+type sampler struct {
+	lock sync.Mutex
+	rate float64
+	rnd  *rand.Rand
+}
+
+func newSampler(rate float64, seed *int64) *sampler {
+	var src rand.Source
+	if seed != nil {
+		src = rand.NewPCG(uint64(*seed), uint64(*seed)) //nolint:gosec
+	} else {
+		src = rand.NewPCG(rand.Uint64(), rand.Uint64()) //nolint:gosec
+	}
+
+	return &sampler{
+		rate: rate,
+		rnd:  rand.New(src), //nolint:gosec
+	}
+}
+
+// sample returns true if the entry is kept.
+func (s *sampler) sample() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.rnd.Float64() < s.rate
+}
+
+// isGuaranteedLevel returns true for the levels that are never sampled out.
+func isGuaranteedLevel(level logrus.Level) bool {
+	return level <= logrus.ErrorLevel
+}
+
+// SetSampling keeps at random only the given share of the entries below level
+// Error, for example 0.1 keeps about one in ten of them. Error and Fatal
+// entries are always kept. A rate of 1 or more disables the sampling.
+// While sampling, entries carry a sampled field, true for the entries that went
+// through the sampler and false for the Error and Fatal ones.
+func (l *daprLogger) SetSampling(rate float64) {
+	l.core.lock.Lock()
+	defer l.core.lock.Unlock()
+
+	if rate >= 1 {
+		l.core.sampler.Store(nil)
+		return
+	}
+
+	l.core.sampler.Store(newSampler(max(rate, 0), l.core.samplerSeed))
+}
+
+// SetSamplerSeed seeds the random generator of the sampling, so the sampling
+// decisions are the same for the same sequence of entries. By default the
+// generator is seeded at random.
+func (l *daprLogger) SetSamplerSeed(seed int64) {
+	l.core.lock.Lock()
+	defer l.core.lock.Unlock()
+
+	l.core.samplerSeed = &seed
+	if s := l.core.sampler.Load(); s != nil {
+		l.core.sampler.Store(newSampler(s.rate, &seed))
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetSamplerSeed(t *testing.T) {
+	// sampledMessages returns the messages kept out of 100 entries.
+	sampledMessages := func(seed int64) []string {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(false)
+		testLogger.SetSampling(0.5)
+		testLogger.SetSamplerSeed(seed)
+
+		for i := range 100 {
+			testLogger.Info("entry " + strconv.Itoa(i))
+		}
+
+		var kept []string
+		for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+			kept = append(kept, line[strings.Index(line, "msg="):])
+		}
+		return kept
+	}
+
+	t.Run("same seed", func(t *testing.T) {
+		first := sampledMessages(42)
+		second := sampledMessages(42)
+
+		assert.Equal(t, first, second)
+		assert.Greater(t, len(first), 10)
+		assert.Less(t, len(first), 90)
+	})
+
+	t.Run("different seed", func(t *testing.T) {
+		assert.NotEqual(t, sampledMessages(42), sampledMessages(7))
+	})
+}
+
+func TestSetSampling(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.SetSamplerSeed(1)
+
+	t.Run("guaranteed levels", func(t *testing.T) {
+		testLogger.SetSampling(0)
+		testLogger.Info("dropped")
+		testLogger.Warn("dropped")
+		testLogger.Error("kept")
+
+		assert.Equal(t, 1, strings.Count(buf.String(), "\n"))
+		assert.Contains(t, buf.String(), "kept")
+		buf.Reset()
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		testLogger.SetSampling(1)
+		for range 10 {
+			testLogger.Info("kept")
+		}
+
+		assert.Equal(t, 10, strings.Count(buf.String(), "\n"))
+	})
+}
+
+func TestSampledField(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	t.Run("no sampler", func(t *testing.T) {
+		testLogger.Info("not sampled")
+
+		assert.NotContains(t, readTestEntry(t, &buf), logFieldSampled)
+	})
+
+	testLogger.SetSampling(0.999)
+	testLogger.SetSamplerSeed(1)
+
+	t.Run("sampled level", func(t *testing.T) {
+		testLogger.Info("sampled")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "sampled", o[logFieldMessage])
+		assert.Equal(t, true, o[logFieldSampled])
+	})
+
+	t.Run("guaranteed level", func(t *testing.T) {
+		testLogger.Error("guaranteed")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "guaranteed", o[logFieldMessage])
+		assert.Equal(t, false, o[logFieldSampled])
+	})
+}
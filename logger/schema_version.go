@@ -0,0 +1,26 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+const logFieldSchemaVer = "schema_version"
+
+// DefaultSchemaVersion is the version of the Dapr log schema emitted in the
+// schema_version field, unless changed with SetSchemaVersion.
+const DefaultSchemaVersion = "1"
+
+// SetSchemaVersion sets the schema_version field added to every entry, so
+// consumers can branch on the log schema. An empty version omits the field.
+func (l *daprLogger) SetSchemaVersion(version string) {
+	l.core.schemaVersion.Store(&version)
+}
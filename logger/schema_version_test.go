@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetSchemaVersion(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	schemaVersions := func() []any {
+		var versions []any
+		for _, o := range readTestEntries(t, &buf) {
+			versions = append(versions, o[logFieldSchemaVer])
+		}
+
+		return versions
+	}
+
+	testLogger.Info("default")
+	assert.Equal(t, []any{DefaultSchemaVersion}, schemaVersions())
+
+	derived := testLogger.WithLogType(LogTypeRequest)
+	testLogger.SetSchemaVersion("2")
+	testLogger.Info("info")
+	testLogger.Error("error")
+	testLogger.WithFields(map[string]any{"a": 1}).Warn("with fields")
+	derived.Info("derived before the change")
+	assert.Equal(t, []any{"2", "2", "2", "2"}, schemaVersions())
+
+	testLogger.SetSchemaVersion("")
+	testLogger.Info("omitted")
+	assert.Equal(t, []any{nil}, schemaVersions())
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+const (
+	logFieldDocumentID       = "document_id"
+	logFieldSchemaViolations = "schema_violations"
+)
+
+// SchemaViolation is a JSON Schema rule a document doesn't satisfy.
+type SchemaViolation struct {
+	// Path is the JSON pointer of the invalid value in the document.
+	Path string `json:"path"`
+	// Keyword is the JSON Schema keyword that failed, such as "required".
+	Keyword string `json:"keyword"`
+	// Message describes the violation.
+	Message string `json:"message"`
+}
+
+// LogSchemaViolations logs at level Error the JSON Schema violations of the
+// document with the given ID.
+func (l *daprLogger) LogSchemaViolations(documentID string, violations []SchemaViolation) {
+	if violations == nil {
+		violations = []SchemaViolation{}
+	}
+
+	l.WithFields(map[string]any{
+		logFieldDocumentID:       documentID,
+		logFieldSchemaViolations: violations,
+	}).Errorf("Document %s has %d schema violations", documentID, len(violations))
+}
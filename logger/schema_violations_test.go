@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogSchemaViolations(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	testLogger.LogSchemaViolations("components/statestore.yaml", []SchemaViolation{
+		{Path: "/spec/type", Keyword: "required", Message: "type is required"},
+		{Path: "/spec/version", Keyword: "pattern", Message: "version must match ^v[0-9]+$"},
+	})
+
+	o := readTestEntry(t, &buf)
+
+	assert.Equal(t, "error", o[logFieldLevel])
+	assert.Equal(t, "components/statestore.yaml", o[logFieldDocumentID])
+	assert.Equal(t, []any{
+		map[string]any{"path": "/spec/type", "keyword": "required", "message": "type is required"},
+		map[string]any{"path": "/spec/version", "keyword": "pattern", "message": "version must match ^v[0-9]+$"},
+	}, o[logFieldSchemaViolations])
+}
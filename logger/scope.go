@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"strings"
+)
+
+// scopeSeparator separates the segments of hierarchical logger names, such as
+// runtime.actors.placement.
+const scopeSeparator = "."
+
+// Child returns the logger named after l's name and suffix, separated with a
+// dot, created and registered like with NewLogger if it doesn't exist yet.
+func (l *daprLogger) Child(suffix string) Logger {
+	return NewLogger(l.name + scopeSeparator + suffix)
+}
+
+// isDescendant returns true if name is a dot-separated descendant of
+// ancestor, such as runtime.actors.placement of runtime.actors.
+func isDescendant(name, ancestor string) bool {
+	return strings.HasPrefix(name, ancestor+scopeSeparator)
+}
+
+// scopeDepth returns the number of segments of the hierarchical name.
+func scopeDepth(name string) int {
+	return strings.Count(name, scopeSeparator) + 1
+}
+
+// nearestAncestorLocked returns the registered logger with the longest name
+// name descends from, or nil if there is none. The caller must hold
+// globalLoggersLock.
+func nearestAncestorLocked(name string) *daprLogger {
+	for i := strings.LastIndex(name, scopeSeparator); i > 0; i = strings.LastIndex(name[:i], scopeSeparator) {
+		if dl, ok := globalLoggers[name[:i]].(*daprLogger); ok {
+			return dl
+		}
+	}
+
+	return nil
+}
+
+// inherit sets the output level, output and output format of l to those of
+// parent.
+func (l *daprLogger) inherit(parent *daprLogger) {
+	parent.core.lock.Lock()
+	out := parent.logger.Logger.Out
+	jsonEnabled := isJSONFormatter(parent.logger.Logger.Formatter)
+	jsonFormat := parent.core.jsonFormat
+	parent.core.lock.Unlock()
+
+	l.SetOutputLevel(fromLogrusLevel(parent.logger.Logger.GetLevel()))
+	l.SetOutput(out)
+	l.SetJSONFormat(jsonFormat)
+	l.EnableJSONOutput(jsonEnabled)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"maps"
+	"sync"
+	"sync/atomic"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// scopeDefaultFields holds the default fields per scope. The maps are
+	// replaced, never modified, so they can be read without the lock.
+	scopeDefaultFields     atomic.Pointer[map[string]map[string]any]
+	scopeDefaultFieldsLock sync.Mutex
+)
+
+// SetScopeDefaultFields sets the fields included in every entry of the loggers
+// with the given scope. Fields set with WithFields take precedence.
+// Passing no fields removes the defaults of the scope.
+func SetScopeDefaultFields(scope string, fields map[string]any) {
+	scopeDefaultFieldsLock.Lock()
+	defer scopeDefaultFieldsLock.Unlock()
+
+	var defaults map[string]map[string]any
+	if cur := scopeDefaultFields.Load(); cur != nil {
+		defaults = maps.Clone(*cur)
+	} else {
+		defaults = make(map[string]map[string]any, 1)
+	}
+
+	if len(fields) == 0 {
+		delete(defaults, scope)
+	} else {
+		defaults[scope] = maps.Clone(fields)
+	}
+
+	scopeDefaultFields.Store(&defaults)
+}
+
+// addScopeDefaultFields adds the default fields of scope missing in data.
+func addScopeDefaultFields(scope string, data logrus.Fields) {
+	defaults := scopeDefaultFields.Load()
+	if defaults == nil {
+		return
+	}
+
+	for k, v := range (*defaults)[scope] {
+		if _, ok := data[k]; !ok {
+			data[k] = v
+		}
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetScopeDefaultFields(t *testing.T) {
+	SetScopeDefaultFields(fakeLoggerName, map[string]any{
+		"component": "state.redis",
+		"team":      "storage",
+	})
+	t.Cleanup(func() { SetScopeDefaultFields(fakeLoggerName, nil) })
+
+	logEntry := func(t *testing.T, l Logger, buf *bytes.Buffer) map[string]any {
+		t.Helper()
+
+		l.Info("hello")
+
+		return readTestEntry(t, buf)
+	}
+
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	t.Run("configured scope", func(t *testing.T) {
+		o := logEntry(t, testLogger, &buf)
+		assert.Equal(t, "state.redis", o["component"])
+		assert.Equal(t, "storage", o["team"])
+	})
+
+	t.Run("WithFields overrides", func(t *testing.T) {
+		o := logEntry(t, testLogger.WithFields(map[string]any{"team": "runtime"}), &buf)
+		assert.Equal(t, "state.redis", o["component"])
+		assert.Equal(t, "runtime", o["team"])
+	})
+
+	t.Run("other scope", func(t *testing.T) {
+		otherLogger := newDaprLogger("otherLogger")
+		otherLogger.SetOutput(&buf)
+		otherLogger.EnableJSONOutput(true)
+
+		o := logEntry(t, otherLogger, &buf)
+		assert.NotContains(t, o, "component")
+		assert.NotContains(t, o, "team")
+	})
+
+	t.Run("removed defaults", func(t *testing.T) {
+		SetScopeDefaultFields(fakeLoggerName, nil)
+
+		o := logEntry(t, testLogger, &buf)
+		assert.NotContains(t, o, "component")
+	})
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChild(t *testing.T) {
+	var buf bytes.Buffer
+	parent := NewLogger("testScopeChild.runtime")
+	t.Cleanup(func() {
+		for _, l := range getLoggers() {
+			l.SetOutput(os.Stdout)
+			l.SetOutputLevel(InfoLevel)
+			l.EnableJSONOutput(false)
+		}
+	})
+	parent.SetOutput(&buf)
+	parent.SetOutputLevel(DebugLevel)
+	parent.EnableJSONOutput(true)
+
+	child := parent.Child("actors").Child("placement")
+	assert.Same(t, child, NewLogger("testScopeChild.runtime.actors.placement"))
+	assert.Contains(t, getLoggers(), "testScopeChild.runtime.actors.placement")
+	assert.True(t, child.IsOutputLevelEnabled(DebugLevel))
+
+	child.Debug("inherited")
+	assert.Contains(t, buf.String(), `"scope":"testScopeChild.runtime.actors.placement"`)
+	assert.Contains(t, buf.String(), `"msg":"inherited"`)
+
+	// The loggers already registered aren't changed.
+	parent.SetOutputLevel(WarnLevel)
+	assert.True(t, parent.Child("actors").IsOutputLevelEnabled(DebugLevel))
+}
+
+func TestLevelSpecCascade(t *testing.T) {
+	actors := NewLogger("testScopeCascade.actors")
+	placement := NewLogger("testScopeCascade.actors.placement")
+	reminders := NewLogger("testScopeCascade.actors.reminders")
+	other := NewLogger("testScopeCascade.actorsother")
+	t.Cleanup(func() {
+		for _, l := range getLoggers() {
+			l.SetOutputLevel(InfoLevel)
+		}
+	})
+
+	require.NoError(t, ApplyLevelSpec("info,testScopeCascade.actors.placement:debug,testScopeCascade.actors:warn"))
+
+	assert.False(t, actors.IsOutputLevelEnabled(InfoLevel))
+	assert.False(t, reminders.IsOutputLevelEnabled(InfoLevel))
+	assert.True(t, reminders.IsOutputLevelEnabled(WarnLevel))
+	// The deepest ancestor takes precedence, whatever the order.
+	assert.True(t, placement.IsOutputLevelEnabled(DebugLevel))
+	assert.True(t, other.IsOutputLevelEnabled(InfoLevel))
+	assert.False(t, other.IsOutputLevelEnabled(DebugLevel))
+
+	spec, err := parseLevelSpec("info,runtime.*:debug,runtime.actors:warn")
+	require.NoError(t, err)
+	assert.Equal(t, WarnLevel, spec.levelFor("runtime.actors"))
+	assert.Equal(t, DebugLevel, spec.levelFor("runtime.actors.placement"))
+	assert.Equal(t, InfoLevel, spec.levelFor("components"))
+}
+
+func TestLevelHandlerCascade(t *testing.T) {
+	actors := NewLogger("testScopeHandler.actors")
+	placement := NewLogger("testScopeHandler.actors.placement")
+	t.Cleanup(func() {
+		for _, l := range getLoggers() {
+			l.SetOutputLevel(InfoLevel)
+		}
+	})
+
+	put := func(name string) int {
+		req := httptest.NewRequest(http.MethodPut, "/?logger="+name, strings.NewReader(`{"level":"debug"}`))
+		rec := httptest.NewRecorder()
+		LevelHandler().ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusOK, put("testScopeHandler.actors"))
+	assert.True(t, actors.IsOutputLevelEnabled(DebugLevel))
+	assert.True(t, placement.IsOutputLevelEnabled(DebugLevel))
+
+	// A scope without a logger of its own sets the level of its descendants.
+	placement.SetOutputLevel(InfoLevel)
+	assert.Equal(t, http.StatusOK, put("testScopeHandler"))
+	assert.True(t, placement.IsOutputLevelEnabled(DebugLevel))
+
+	assert.Equal(t, http.StatusNotFound, put("testScopeHandler.act"))
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"maps"
+)
+
+const (
+	logFieldCheckName   = "check_name"
+	logFieldCheckPassed = "check_passed"
+)
+
+// LogSelfCheck logs the result of the self-check name with details merged
+// into the entry fields, at level Debug when it passed and at level Error when
+// it failed.
+func (l *daprLogger) LogSelfCheck(name string, passed bool, details map[string]any) {
+	fields := make(map[string]any, len(details)+2)
+	maps.Copy(fields, details)
+	fields[logFieldCheckName] = name
+	fields[logFieldCheckPassed] = passed
+
+	if !passed {
+		l.WithFields(fields).Errorf("Self-check %s failed", name)
+		return
+	}
+
+	l.WithFields(fields).Debugf("Self-check %s passed", name)
+}
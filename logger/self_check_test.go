@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogSelfCheck(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.SetOutputLevel(DebugLevel)
+
+	t.Run("passed", func(t *testing.T) {
+		testLogger.LogSelfCheck("disk-space", true, map[string]any{"free_mb": 2048})
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "debug", o[logFieldLevel])
+		assert.Equal(t, "disk-space", o[logFieldCheckName])
+		assert.Equal(t, true, o[logFieldCheckPassed])
+		assert.InDelta(t, float64(2048), o["free_mb"], 0)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		testLogger.LogSelfCheck("placement", false, map[string]any{
+			"reachable":    false,
+			"check_passed": true,
+		})
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "error", o[logFieldLevel])
+		assert.Equal(t, false, o["reachable"])
+		// Details don't override the check result.
+		assert.Equal(t, false, o[logFieldCheckPassed])
+	})
+
+	t.Run("no details", func(t *testing.T) {
+		testLogger.LogSelfCheck("clock-skew", true, nil)
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "clock-skew", o[logFieldCheckName])
+	})
+}
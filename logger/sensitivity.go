@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"io"
+)
+
+// SensitivityLevel is the data-governance classification of an entry.
+type SensitivityLevel string
+
+const (
+	// SensitivityPublic is for entries that can be disclosed publicly.
+	SensitivityPublic SensitivityLevel = "public"
+	// SensitivityInternal is for entries restricted to the organization.
+	SensitivityInternal SensitivityLevel = "internal"
+	// SensitivityConfidential is for entries restricted to authorized people.
+	SensitivityConfidential SensitivityLevel = "confidential"
+	// SensitivityRestricted is for entries whose access must be audited.
+	SensitivityRestricted SensitivityLevel = "restricted"
+)
+
+const logFieldSensitivity = "sensitivity"
+
+// WithSensitivity returns a logger whose entries carry level in the sensitivity field.
+func (l *daprLogger) WithSensitivity(level SensitivityLevel) Logger {
+	return l.derive(l.logger.WithField(logFieldSensitivity, level))
+}
+
+// SetAuditedSink sets the destination receiving a copy of the Restricted entries,
+// in addition to the output. A nil sink disables it.
+func (l *daprLogger) SetAuditedSink(w io.Writer) {
+	l.core.lock.Lock()
+	defer l.core.lock.Unlock()
+
+	l.core.auditedSink = w
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithSensitivity(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	for _, level := range []SensitivityLevel{
+		SensitivityPublic,
+		SensitivityInternal,
+		SensitivityConfidential,
+		SensitivityRestricted,
+	} {
+		t.Run(string(level), func(t *testing.T) {
+			testLogger.WithSensitivity(level).Info("classified")
+
+			o := readTestEntry(t, &buf)
+
+			assert.Equal(t, string(level), o[logFieldSensitivity])
+			assert.Equal(t, "classified", o[logFieldMessage])
+		})
+	}
+}
+
+func TestSetAuditedSink(t *testing.T) {
+	var buf, audited bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.SetAuditedSink(&audited)
+
+	t.Run("restricted entries reach the sink", func(t *testing.T) {
+		testLogger.WithSensitivity(SensitivityRestricted).Info("restricted")
+
+		out, _ := buf.ReadBytes('\n')
+		b, _ := audited.ReadBytes('\n')
+		assert.Equal(t, out, b)
+
+		var o map[string]any
+		require.NoError(t, json.Unmarshal(b, &o))
+		assert.Equal(t, string(SensitivityRestricted), o[logFieldSensitivity])
+		assert.Equal(t, "restricted", o[logFieldMessage])
+	})
+
+	t.Run("other entries don't reach the sink", func(t *testing.T) {
+		testLogger.WithSensitivity(SensitivityConfidential).Info("confidential")
+		testLogger.Info("unclassified")
+
+		assert.Equal(t, 2, bytes.Count(buf.Bytes(), []byte{'\n'}))
+		assert.Zero(t, audited.Len())
+		buf.Reset()
+	})
+
+	t.Run("disabled sink", func(t *testing.T) {
+		testLogger.SetAuditedSink(nil)
+		testLogger.WithSensitivity(SensitivityRestricted).Info("restricted")
+
+		assert.NotZero(t, buf.Len())
+		assert.Zero(t, audited.Len())
+	})
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"encoding/base64"
+	"unicode/utf8"
+)
+
+const (
+	logFieldSerdeFormat    = "serde_format"
+	logFieldSerdeOp        = "serde_op"
+	logFieldPayloadLen     = "payload_len"
+	logFieldPayloadSnippet = "payload_snippet"
+	logFieldPayloadEnc     = "payload_encoding"
+)
+
+// payloadEncodingBase64 is the payload_encoding of the snippets of the payloads
+// that aren't UTF-8 text.
+const payloadEncodingBase64 = "base64"
+
+// Serialization operations logged by LogSerdeError.
+const (
+	SerdeOpEncode = "encode"
+	SerdeOpDecode = "decode"
+)
+
+// LogSerdeError logs at level Error that encoding or decoding a payload of
+// payloadLen bytes in the given format failed.
+// To include a truncated snippet of the payload, call it on a logger returned
+// by WithPayloadSnippet.
+func (l *daprLogger) LogSerdeError(format string, op string, err error, payloadLen int) {
+	fields := map[string]any{
+		logFieldSerdeFormat: format,
+		logFieldSerdeOp:     op,
+		logFieldPayloadLen:  payloadLen,
+	}
+	l.WithError(err).WithFields(fields).Errorf("Failed to %s %s payload", op, format)
+}
+
+// WithPayloadSnippet returns a logger with up to the first maxBytes bytes of
+// payload in the payload_snippet field. If the payload isn't UTF-8 text, the
+// snippet is encoded in base64 and the payload_encoding field is set to
+// "base64". A maxBytes of zero or less doesn't add the snippet.
+func (l *daprLogger) WithPayloadSnippet(payload []byte, maxBytes int) Logger {
+	if maxBytes <= 0 {
+		return l
+	}
+
+	snippet := string(payload)
+	if len(payload) > maxBytes {
+		// Don't cut a multi-byte character in half.
+		snippet = truncateString(string(payload[:maxBytes+1]), maxBytes)
+		payload = payload[:maxBytes]
+	}
+
+	// More than an incomplete character cut off means it isn't text either.
+	if !utf8.ValidString(snippet) || len(payload)-len(snippet) >= utf8.UTFMax {
+		return l.WithFields(map[string]any{
+			logFieldPayloadSnippet: base64.StdEncoding.EncodeToString(payload),
+			logFieldPayloadEnc:     payloadEncodingBase64,
+		})
+	}
+
+	return l.WithFields(map[string]any{
+		logFieldPayloadSnippet: snippet,
+	})
+}
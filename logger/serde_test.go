@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogSerdeError(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	payload := []byte(`{"orderId": 42, "items": [`)
+
+	t.Run("decode failure", func(t *testing.T) {
+		var v map[string]any
+		decodeErr := json.Unmarshal(payload, &v)
+		require.Error(t, decodeErr)
+
+		testLogger.LogSerdeError("json", SerdeOpDecode, decodeErr, len(payload))
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "error", o[logFieldLevel])
+		assert.Equal(t, "Failed to decode json payload", o[logFieldMessage])
+		assert.Equal(t, "json", o[logFieldSerdeFormat])
+		assert.Equal(t, SerdeOpDecode, o[logFieldSerdeOp])
+		assert.InDelta(t, float64(len(payload)), o[logFieldPayloadLen], 0)
+		assert.Equal(t, decodeErr.Error(), o[logFieldError])
+		assert.NotContains(t, o, logFieldPayloadSnippet)
+	})
+
+	t.Run("with payload snippet", func(t *testing.T) {
+		testLogger.WithPayloadSnippet(payload, 10).LogSerdeError("json", SerdeOpDecode, nil, len(payload))
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, `{"orderId"`, o[logFieldPayloadSnippet])
+	})
+
+	t.Run("snippet disabled", func(t *testing.T) {
+		testLogger.WithPayloadSnippet(payload, 0).LogSerdeError("json", SerdeOpEncode, nil, len(payload))
+
+		o := readTestEntry(t, &buf)
+		assert.NotContains(t, o, logFieldPayloadSnippet)
+	})
+
+	t.Run("snippet keeps characters whole", func(t *testing.T) {
+		testLogger.WithPayloadSnippet([]byte("ab€"), 3).LogSerdeError("json", SerdeOpDecode, nil, 5)
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "ab", o[logFieldPayloadSnippet])
+		assert.NotContains(t, o, logFieldPayloadEnc)
+	})
+
+	t.Run("binary payload", func(t *testing.T) {
+		binary := []byte{0x0a, 0x03, 0xff, 0xfe, 0x80, 0x81, 0x82, 0x83, 0x00, 0x01}
+		testLogger.WithPayloadSnippet(binary, 8).LogSerdeError("protobuf", SerdeOpDecode, nil, len(binary))
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, base64.StdEncoding.EncodeToString(binary[:8]), o[logFieldPayloadSnippet])
+		assert.Equal(t, payloadEncodingBase64, o[logFieldPayloadEnc])
+	})
+
+	t.Run("binary payload cut on continuation bytes", func(t *testing.T) {
+		binary := []byte{'a', 'b', 0x80, 0x81, 0x82, 0x83, 0x84, 0x85}
+		testLogger.WithPayloadSnippet(binary, 7).LogSerdeError("protobuf", SerdeOpDecode, nil, len(binary))
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, base64.StdEncoding.EncodeToString(binary[:7]), o[logFieldPayloadSnippet])
+		assert.Equal(t, payloadEncodingBase64, o[logFieldPayloadEnc])
+	})
+}
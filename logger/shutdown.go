@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"time"
+)
+
+const (
+	logFieldShutdownPhase       = "shutdown_phase"
+	logFieldShutdownRemainingMs = "shutdown_remaining_ms"
+	logFieldShutdownDurationMs  = "shutdown_duration_ms"
+
+	// shutdownPhaseComplete is the phase logged by LogShutdownComplete.
+	shutdownPhaseComplete = "complete"
+)
+
+// LogShutdownPhase logs at level Info that the graceful shutdown entered
+// phase, with the time remaining before the shutdown deadline.
+func (l *daprLogger) LogShutdownPhase(phase string, remaining time.Duration) {
+	l.WithFields(map[string]any{
+		logFieldShutdownPhase:       phase,
+		logFieldShutdownRemainingMs: toMilliseconds(remaining),
+	}).Infof("Shutdown phase: %s", phase)
+}
+
+// LogShutdownComplete logs at level Info that the graceful shutdown completed
+// after duration.
+func (l *daprLogger) LogShutdownComplete(duration time.Duration) {
+	l.WithFields(map[string]any{
+		logFieldShutdownPhase:      shutdownPhaseComplete,
+		logFieldShutdownDurationMs: toMilliseconds(duration),
+	}).Info("Shutdown complete")
+}
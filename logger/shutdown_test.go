@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogShutdown(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	testLogger.LogShutdownPhase("draining", 5*time.Second)
+	testLogger.LogShutdownComplete(1500 * time.Millisecond)
+
+	o := readTestEntry(t, &buf)
+	assert.Equal(t, "info", o[logFieldLevel])
+	assert.Equal(t, "draining", o[logFieldShutdownPhase])
+	assert.InDelta(t, float64(5000), o[logFieldShutdownRemainingMs], 0.001)
+
+	o = readTestEntry(t, &buf)
+	assert.Equal(t, "info", o[logFieldLevel])
+	assert.Equal(t, "Shutdown complete", o[logFieldMessage])
+	assert.Equal(t, shutdownPhaseComplete, o[logFieldShutdownPhase])
+	assert.InDelta(t, float64(1500), o[logFieldShutdownDurationMs], 0.001)
+	assert.NotContains(t, o, logFieldShutdownRemainingMs)
+}
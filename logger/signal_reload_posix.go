@@ -0,0 +1,59 @@
+//go:build !windows
+
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+)
+
+// EnableSignalReload applies to all registered loggers the options returned by
+// opts each time the process receives SIGHUP, for example to switch the output
+// level or format of a running process. It returns a function to stop
+// listening for SIGHUP. Failures to apply the options are printed to stderr.
+// It's a no-op on Windows.
+func EnableSignalReload(opts func() Options) (stop func()) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+
+	doneCh := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		for {
+			select {
+			case <-doneCh:
+				return
+			case <-sigCh:
+				o := opts()
+				if err := ApplyOptionsToLoggers(&o); err != nil {
+					fmt.Fprintf(os.Stderr, "Failed to reload log options, %v\n", err)
+				}
+			}
+		}
+	})
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			signal.Stop(sigCh)
+			close(doneCh)
+			wg.Wait()
+		})
+	}
+}
@@ -0,0 +1,70 @@
+//go:build !windows
+
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnableSignalReload(t *testing.T) {
+	testLogger := NewLogger("signalReloadLogger").(*daprLogger)
+	testLogger.EnableJSONOutput(false)
+	testLogger.SetOutputLevel(InfoLevel)
+	t.Cleanup(func() {
+		for _, l := range getLoggers() {
+			l.EnableJSONOutput(false)
+			l.SetOutputLevel(InfoLevel)
+		}
+	})
+
+	reloaded := make(chan struct{}, 1)
+	stop := EnableSignalReload(func() Options {
+		defer func() { reloaded <- struct{}{} }()
+
+		opts := DefaultOptions()
+		opts.JSONFormatEnabled = true
+		opts.OutputLevel = "debug"
+		return opts
+	})
+	t.Cleanup(stop)
+
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGHUP))
+
+	select {
+	case <-reloaded:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "options not reloaded")
+	}
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.True(c, testLogger.IsOutputLevelEnabled(DebugLevel))
+
+		testLogger.core.lock.Lock()
+		_, isJSON := testLogger.logger.Logger.Formatter.(*logrus.JSONFormatter)
+		testLogger.core.lock.Unlock()
+		assert.True(c, isJSON)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	stop()
+	// Stopping twice is fine.
+	stop()
+}
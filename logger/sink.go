@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/sirupsen/logrus"
+)
+
+// SinkOptions are the options of a destination added with AddSink.
+type SinkOptions struct {
+	// Level is the most verbose level written to the sink. All the entries
+	// output by the logger are written if empty.
+	Level LogLevel
+	// JSON is the flag to write JSON instead of text.
+	JSON bool
+	// JSONFormat is the layout of the JSON output.
+	JSONFormat JSONFormat
+	// Colors is the flag to colorize the text output.
+	Colors bool
+	// Encoding is the encoding of the entries, OutputEncodingText if empty.
+	// OutputEncodingCBOR can't be combined with Colors.
+	Encoding OutputEncoding
+}
+
+// sink is a destination added with AddSink.
+type sink struct {
+	w         io.Writer
+	opts      SinkOptions
+	level     logrus.Level
+	formatter logrus.Formatter
+	// fromOptions is true for the sinks of Options.Sinks.
+	fromOptions bool
+}
+
+func (s sink) newFormatter(timestampFormat string) logrus.Formatter {
+	return newFormatter(s.opts.Encoding, s.opts.JSON, s.opts.JSONFormat, timestampFormat, s.opts.Colors)
+}
+
+// AddSink adds w as a destination receiving the entries in addition to the
+// output, formatted and filtered with opts, for example to write JSON to a
+// file at level Debug and colorized text to stdout at level Info. The entries
+// are still filtered by the output level of the logger first, so it must be
+// the most verbose level of the sinks. Set the output to io.Discard to only
+// write to the sinks.
+func (l *daprLogger) AddSink(w io.Writer, opts SinkOptions) {
+	l.core.lock.Lock()
+	defer l.core.lock.Unlock()
+
+	l.core.sinks = append(l.core.sinks, l.newSinkLocked(w, opts))
+}
+
+// setOptionsSinks replaces the sinks of Options.Sinks with sinks, writing to
+// writers. The sinks added with AddSink are kept.
+func (l *daprLogger) setOptionsSinks(sinks []OptionsSink, writers []io.WriteCloser) {
+	l.core.lock.Lock()
+	defer l.core.lock.Unlock()
+
+	l.core.sinks = slices.DeleteFunc(l.core.sinks, func(s sink) bool {
+		return s.fromOptions
+	})
+	for i, s := range sinks {
+		ns := l.newSinkLocked(writers[i], s.SinkOptions)
+		ns.fromOptions = true
+		l.core.sinks = append(l.core.sinks, ns)
+	}
+}
+
+// newSinkLocked returns a sink writing to w. The caller must hold core.lock.
+func (l *daprLogger) newSinkLocked(w io.Writer, opts SinkOptions) sink {
+	s := sink{w: w, opts: opts, level: logrus.TraceLevel}
+	if opts.Level != "" && opts.Level != UndefinedLevel {
+		s.level = toLogrusLevel(opts.Level)
+	}
+	s.formatter = s.newFormatter(l.core.timestampFormat)
+
+	return s
+}
+
+// writeSinksLocked writes entry to the sinks whose level is enabled. The caller
+// must hold core.lock.
+func (l *daprLogger) writeSinksLocked(entry *logrus.Entry) {
+	for _, s := range l.core.sinks {
+		if entry.Level > s.level {
+			continue
+		}
+
+		serialized, err := s.formatter.Format(entry)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to obtain reader, %v\n", err)
+			continue
+		}
+
+		if _, err = writeOutput(s.w, entry, serialized); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to write to log sink, %v\n", err)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddSink(t *testing.T) {
+	testLogger := getTestLogger(io.Discard)
+	testLogger.SetOutputLevel(DebugLevel)
+
+	var jsonBuf, textBuf bytes.Buffer
+	testLogger.AddSink(&jsonBuf, SinkOptions{Level: DebugLevel, JSON: true})
+	testLogger.AddSink(&textBuf, SinkOptions{Level: InfoLevel, Colors: true})
+
+	testLogger.Debug("debug message")
+	testLogger.Info("info message")
+	// Below the output level of the logger.
+	testLogger.Trace("trace message")
+
+	lines := strings.Split(strings.TrimSpace(jsonBuf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var o map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &o))
+	assert.Equal(t, "debug message", o[logFieldMessage])
+	assert.Equal(t, "debug", o[logFieldLevel])
+
+	assert.NotContains(t, textBuf.String(), "debug message")
+	assert.Contains(t, textBuf.String(), ansiGreen+"INFO "+ansiReset)
+	assert.Contains(t, textBuf.String(), "info message")
+
+	t.Run("sinks follow the timestamp format", func(t *testing.T) {
+		jsonBuf.Reset()
+		testLogger.SetTimestampFormat(time.DateOnly, true)
+		testLogger.Info("dated")
+
+		var o map[string]any
+		require.NoError(t, json.Unmarshal(jsonBuf.Bytes(), &o))
+		assert.Len(t, o[logFieldTimeStamp], len(time.DateOnly))
+	})
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"slices"
+	"unicode/utf8"
+
+	"github.com/sirupsen/logrus"
+)
+
+const logFieldTruncated = "truncated"
+
+// sizeLimitExemptFields are the envelope fields never truncated.
+var sizeLimitExemptFields = []string{
+	logFieldScope,
+	logFieldType,
+	logFieldInstance,
+	logFieldDaprVer,
+	logFieldAppID,
+	logFieldComponentVer,
+	logFieldSchemaVer,
+}
+
+// SetSizeLimits sets the maximum size in bytes of the message and of the
+// string fields of the entries, such as payloads, the envelope fields such as
+// scope excepted. The longer ones are cut at
+// the limit and the entry gets the field truncated=true. Zero disables a
+// limit.
+func (l *daprLogger) SetSizeLimits(maxMessageBytes, maxFieldBytes int) {
+	l.core.maxMessageBytes.Store(int64(max(maxMessageBytes, 0)))
+	l.core.maxFieldBytes.Store(int64(max(maxFieldBytes, 0)))
+}
+
+// truncateEntry cuts the message and the string fields of entry to the size
+// limits, except the envelope fields, returning true if any was cut.
+func truncateEntry(entry *logrus.Entry, maxMessageBytes, maxFieldBytes int) bool {
+	var truncated bool
+
+	if maxMessageBytes > 0 && len(entry.Message) > maxMessageBytes {
+		entry.Message = truncateString(entry.Message, maxMessageBytes)
+		truncated = true
+	}
+
+	if maxFieldBytes <= 0 {
+		return truncated
+	}
+
+	for k, v := range entry.Data {
+		if slices.Contains(sizeLimitExemptFields, k) {
+			continue
+		}
+
+		switch v := v.(type) {
+		case string:
+			if len(v) > maxFieldBytes {
+				entry.Data[k] = truncateString(v, maxFieldBytes)
+				truncated = true
+			}
+		case []byte:
+			if len(v) > maxFieldBytes {
+				entry.Data[k] = v[:maxFieldBytes]
+				truncated = true
+			}
+		case error:
+			if msg := v.Error(); len(msg) > maxFieldBytes {
+				entry.Data[k] = truncateString(msg, maxFieldBytes)
+				truncated = true
+			}
+		}
+	}
+
+	return truncated
+}
+
+// truncateString cuts s to at most n bytes, without splitting a UTF-8
+// character.
+func truncateString(s string, n int) string {
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+
+	return s[:n]
+}
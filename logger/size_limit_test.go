@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetSizeLimits(t *testing.T) {
+	var buf bytes.Buffer
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.SetSizeLimits(8, 4)
+
+	t.Run("oversized message and fields are truncated", func(t *testing.T) {
+		body := strings.Repeat("x", 1024)
+		o := loggedTestEntry(t, &buf, func() {
+			testLogger.WithFields(map[string]any{
+				"body":  body,
+				"small": "ok",
+				"count": 123456,
+			}).WithError(errors.New("long error")).Info("a long message")
+		})
+
+		assert.Equal(t, "a long m", o[logFieldMessage])
+		assert.Equal(t, "xxxx", o["body"])
+		assert.Equal(t, "ok", o["small"])
+		assert.InDelta(t, 123456, o["count"], 0)
+		assert.Equal(t, "long", o[logFieldError])
+		assert.Equal(t, true, o[logFieldTruncated])
+	})
+
+	t.Run("entries within the limits are unchanged", func(t *testing.T) {
+		o := loggedTestEntry(t, &buf, func() { testLogger.Info("short") })
+		assert.Equal(t, "short", o[logFieldMessage])
+		assert.NotContains(t, o, logFieldTruncated)
+	})
+
+	t.Run("disabled limits", func(t *testing.T) {
+		testLogger.SetSizeLimits(0, 0)
+		o := loggedTestEntry(t, &buf, func() { testLogger.Info("a long message") })
+		assert.Equal(t, "a long message", o[logFieldMessage])
+	})
+}
+
+func TestTruncateString(t *testing.T) {
+	assert.Equal(t, "ab", truncateString("abc", 2))
+	// é is 2 bytes, so it isn't split.
+	assert.Equal(t, "a", truncateString("aé", 2))
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+)
+
+// slogHandler is a slog.Handler writing the records with a Logger.
+type slogHandler struct {
+	log    Logger
+	attrs  map[string]any
+	groups []string
+}
+
+// NewSlogHandler returns a slog.Handler writing the records with log, so
+// their entries carry the fields of log, such as its scope. Record attributes
+// become entry fields, and groups nested objects.
+func NewSlogHandler(log Logger) slog.Handler {
+	return &slogHandler{
+		log:   log,
+		attrs: map[string]any{},
+	}
+}
+
+func (h *slogHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return h.log.IsOutputLevelEnabled(fromSlogLevel(level))
+}
+
+func (h *slogHandler) Handle(_ context.Context, r slog.Record) error {
+	fields := cloneAttrs(h.attrs)
+	group := groupAttrs(fields, h.groups)
+	r.Attrs(func(a slog.Attr) bool {
+		addSlogAttr(group, a)
+		return true
+	})
+	pruneEmptyGroups(fields)
+
+	log := h.log
+	if len(fields) > 0 {
+		log = log.WithFields(fields)
+	}
+
+	// Keep the time of the record, which may be earlier than now, such as
+	// for the records passed on by a buffering handler.
+	if dl, ok := log.(*daprLogger); ok && !r.Time.IsZero() {
+		if level, ok := dl.enabledLevel(toLogrusLevel(fromSlogLevel(r.Level))); ok {
+			dl.logAt(level, r.Time, r.Message)
+		}
+		return nil
+	}
+
+	switch fromSlogLevel(r.Level) {
+	case TraceLevel:
+		log.Trace(r.Message)
+	case DebugLevel:
+		log.Debug(r.Message)
+	case InfoLevel:
+		log.Info(r.Message)
+	case WarnLevel:
+		log.Warn(r.Message)
+	default:
+		log.Error(r.Message)
+	}
+
+	return nil
+}
+
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+
+	fields := cloneAttrs(h.attrs)
+	group := groupAttrs(fields, h.groups)
+	for _, a := range attrs {
+		addSlogAttr(group, a)
+	}
+
+	return &slogHandler{
+		log:    h.log,
+		attrs:  fields,
+		groups: h.groups,
+	}
+}
+
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	return &slogHandler{
+		log:    h.log,
+		attrs:  h.attrs,
+		groups: append(slices.Clip(h.groups), name),
+	}
+}
+
+// fromSlogLevel converts a slog level to the LogLevel it falls in.
+func fromSlogLevel(level slog.Level) LogLevel {
+	switch {
+	case level < slog.LevelDebug:
+		return TraceLevel
+	case level < slog.LevelInfo:
+		return DebugLevel
+	case level < slog.LevelWarn:
+		return InfoLevel
+	case level < slog.LevelError:
+		return WarnLevel
+	default:
+		return ErrorLevel
+	}
+}
+
+// toSlogLevel converts a LogLevel to a slog level. Fatal and Panic are above
+// Error.
+func toSlogLevel(level LogLevel) slog.Level {
+	switch level {
+	case TraceLevel:
+		return slog.LevelDebug - 4
+	case DebugLevel:
+		return slog.LevelDebug
+	case WarnLevel:
+		return slog.LevelWarn
+	case ErrorLevel:
+		return slog.LevelError
+	case FatalLevel:
+		return slog.LevelError + 4
+	case PanicLevel:
+		return slog.LevelError + 8
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// groupAttrs returns the map of fields holding the attributes of the groups,
+// creating it if needed.
+func groupAttrs(fields map[string]any, groups []string) map[string]any {
+	for _, g := range groups {
+		sub, ok := fields[g].(map[string]any)
+		if !ok {
+			sub = map[string]any{}
+			fields[g] = sub
+		}
+		fields = sub
+	}
+
+	return fields
+}
+
+// addSlogAttr adds a to fields, following the rules of slog.Handler: empty
+// attributes are ignored and the attributes of groups without a key are
+// inlined.
+func addSlogAttr(fields map[string]any, a slog.Attr) {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return
+	}
+
+	if a.Value.Kind() != slog.KindGroup {
+		fields[a.Key] = a.Value.Any()
+		return
+	}
+
+	attrs := a.Value.Group()
+	if len(attrs) == 0 {
+		return
+	}
+
+	group := fields
+	if a.Key != "" {
+		group = groupAttrs(fields, []string{a.Key})
+	}
+	for _, ga := range attrs {
+		addSlogAttr(group, ga)
+	}
+}
+
+// cloneAttrs deep copies the fields with groups.
+func cloneAttrs(fields map[string]any) map[string]any {
+	cloned := maps.Clone(fields)
+	for k, v := range cloned {
+		if sub, ok := v.(map[string]any); ok {
+			cloned[k] = cloneAttrs(sub)
+		}
+	}
+
+	return cloned
+}
+
+// pruneEmptyGroups removes the groups without attributes, which slog handlers
+// don't output.
+func pruneEmptyGroups(fields map[string]any) {
+	for k, v := range fields {
+		if sub, ok := v.(map[string]any); ok {
+			pruneEmptyGroups(sub)
+			if len(sub) == 0 {
+				delete(fields, k)
+			}
+		}
+	}
+}
+
+// NewSlogLogger returns a Logger with the given scope writing its entries to
+// l, for code that standardized on log/slog. The entry fields, such as scope
+// and app_id, become record attributes. Levels are filtered by the handler of
+// l, so the logger outputs every level by default.
+// The returned logger is not registered, so ApplyOptionsToLoggers ignores it.
+func NewSlogLogger(name string, l *slog.Logger) Logger {
+	dl := newDaprLogger(name)
+	dl.SetOutputLevel(TraceLevel)
+	dl.SetWriteFunc(func(e Entry) error {
+		ctx := context.Background()
+		level := toSlogLevel(e.Level)
+		if !l.Handler().Enabled(ctx, level) {
+			return nil
+		}
+
+		r := slog.NewRecord(e.Time, level, e.Message, 0)
+		for _, k := range slices.Sorted(maps.Keys(e.Fields)) {
+			r.AddAttrs(slog.Any(k, e.Fields[k]))
+		}
+
+		return l.Handler().Handle(ctx, r)
+	})
+
+	return dl
+}
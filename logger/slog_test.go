@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSlogHandler(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.SetAppID("my-app")
+
+	sl := slog.New(NewSlogHandler(testLogger))
+
+	t.Run("dapr fields", func(t *testing.T) {
+		sl.Info("hello", "attempt", 2)
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "info", o[logFieldLevel])
+		assert.Equal(t, "hello", o[logFieldMessage])
+		assert.Equal(t, fakeLoggerName, o[logFieldScope])
+		assert.Equal(t, "my-app", o[logFieldAppID])
+		assert.Contains(t, o, logFieldInstance)
+		assert.InDelta(t, float64(2), o["attempt"], 0)
+	})
+
+	t.Run("levels", func(t *testing.T) {
+		sl.Debug("filtered")
+		assert.Zero(t, buf.Len())
+
+		sl.Warn("warn")
+		assert.Equal(t, "warning", readTestEntry(t, &buf)[logFieldLevel])
+
+		sl.Error("error")
+		assert.Equal(t, "error", readTestEntry(t, &buf)[logFieldLevel])
+
+		assert.False(t, sl.Enabled(context.Background(), slog.LevelDebug))
+		assert.True(t, sl.Enabled(context.Background(), slog.LevelInfo))
+	})
+
+	t.Run("attrs and groups", func(t *testing.T) {
+		sl.With("component", "state.redis").
+			WithGroup("request").
+			With("method", "GET").
+			Info("served", "status", 200, slog.Group("peer", "ip", "10.0.0.1"), slog.Group("empty"))
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "state.redis", o["component"])
+		assert.Equal(t, map[string]any{
+			"method": "GET",
+			"status": float64(200),
+			"peer":   map[string]any{"ip": "10.0.0.1"},
+		}, o["request"])
+	})
+
+	t.Run("empty group", func(t *testing.T) {
+		sl.WithGroup("request").Info("no attrs")
+
+		o := readTestEntry(t, &buf)
+		assert.NotContains(t, o, "request")
+	})
+
+	t.Run("handlers don't share attrs", func(t *testing.T) {
+		base := sl.WithGroup("g")
+		base.With("a", 1).Info("first")
+		base.With("b", 2).Info("second")
+
+		assert.Equal(t, map[string]any{"a": float64(1)}, readTestEntry(t, &buf)["g"])
+		assert.Equal(t, map[string]any{"b": float64(2)}, readTestEntry(t, &buf)["g"])
+	})
+
+	t.Run("record time", func(t *testing.T) {
+		recorded := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		r := slog.NewRecord(recorded, slog.LevelWarn, "buffered", 0)
+		require.NoError(t, sl.Handler().Handle(context.Background(), r))
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "warning", o[logFieldLevel])
+		assert.Equal(t, "buffered", o[logFieldMessage])
+		ts, err := time.Parse(time.RFC3339Nano, o[logFieldTimeStamp].(string))
+		require.NoError(t, err)
+		assert.True(t, recorded.Equal(ts), ts)
+	})
+}
+
+func TestNewSlogLogger(t *testing.T) {
+	var buf bytes.Buffer
+
+	sl := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	l := NewSlogLogger("slogLogger", sl)
+	l.SetAppID("my-app")
+
+	readRecord := func() map[string]any {
+		return readTestEntry(t, &buf)
+	}
+
+	t.Run("record", func(t *testing.T) {
+		l.WithFields(map[string]any{"attempt": 2}).Warn("retrying")
+
+		o := readRecord()
+		assert.Equal(t, "WARN", o[slog.LevelKey])
+		assert.Equal(t, "retrying", o[slog.MessageKey])
+		assert.Contains(t, o, slog.TimeKey)
+		assert.Equal(t, "slogLogger", o[logFieldScope])
+		assert.Equal(t, "my-app", o[logFieldAppID])
+		assert.Contains(t, o, logFieldInstance)
+		assert.InDelta(t, float64(2), o["attempt"], 0)
+	})
+
+	t.Run("levels filtered by the handler", func(t *testing.T) {
+		l.Debug("filtered")
+		assert.Zero(t, buf.Len())
+
+		l.Info("kept")
+		assert.Equal(t, "INFO", readRecord()[slog.LevelKey])
+	})
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"log"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// stdLogWriter logs each write of a *log.Logger as an entry.
+type stdLogWriter struct {
+	log   *daprLogger
+	level logrus.Level
+}
+
+// StdLogger returns a *log.Logger whose writes are logged as entries at the
+// given level, with the scope and fields of the logger, for the libraries
+// accepting only a *log.Logger such as http.Server.ErrorLog. An undefined
+// level is replaced with Info.
+func (l *daprLogger) StdLogger(level LogLevel) *log.Logger {
+	if toLogLevel(string(level)) == UndefinedLevel {
+		level = InfoLevel
+	}
+
+	return log.New(&stdLogWriter{log: l, level: toLogrusLevel(level)}, "", 0)
+}
+
+func (w *stdLogWriter) Write(p []byte) (int, error) {
+	w.log.print(w.level, strings.TrimSuffix(string(p), "\n"))
+	return len(p), nil
+}
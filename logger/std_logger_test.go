@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStdLogger(t *testing.T) {
+	var buf bytes.Buffer
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	t.Run("writes are logged at the level", func(t *testing.T) {
+		buf.Reset()
+		testLogger.StdLogger(ErrorLevel).Printf("http: TLS handshake error from %s", "10.0.0.1")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "error", o[logFieldLevel])
+		assert.Equal(t, "http: TLS handshake error from 10.0.0.1", o[logFieldMessage])
+		assert.Equal(t, fakeLoggerName, o[logFieldScope])
+	})
+
+	t.Run("disabled levels are discarded", func(t *testing.T) {
+		buf.Reset()
+		testLogger.StdLogger(DebugLevel).Print("debug")
+		assert.Empty(t, buf.String())
+	})
+
+	t.Run("undefined level is info", func(t *testing.T) {
+		buf.Reset()
+		testLogger.StdLogger("verbose").Print("info")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "info", o[logFieldLevel])
+	})
+
+	t.Run("caller is the code calling the std logger", func(t *testing.T) {
+		buf.Reset()
+		testLogger.EnableCaller(true)
+		defer testLogger.EnableCaller(false)
+
+		testLogger.StdLogger(InfoLevel).Print("with caller")
+
+		o := readTestEntry(t, &buf)
+		assert.Contains(t, o[logFieldCaller], "std_logger_test.go:")
+	})
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/dapr/kit/ring"
+)
+
+// entryRetention keeps the most recent entries written by a logger.
+// It is guarded by the core lock.
+type entryRetention struct {
+	size    int
+	entries *ring.Buffered[Entry]
+}
+
+func (r *entryRetention) add(e Entry) {
+	if r.entries.Len() == r.size {
+		r.entries.RemoveFront()
+	}
+	r.entries.AppendBack(&e)
+}
+
+func (r *entryRetention) snapshot() []Entry {
+	entries := make([]Entry, 0, r.entries.Len())
+	r.entries.Range(func(e *Entry) bool {
+		entries = append(entries, *e)
+		return true
+	})
+
+	return entries
+}
+
+// EnableEntryRetention keeps in memory the last size entries written, which
+// are included in the support bundle. A size of zero or less disables it.
+func (l *daprLogger) EnableEntryRetention(size int) {
+	l.core.lock.Lock()
+	defer l.core.lock.Unlock()
+
+	if size <= 0 {
+		l.core.retention = nil
+		return
+	}
+
+	r := &entryRetention{
+		size:    size,
+		entries: ring.NewBuffered[Entry](size),
+	}
+	// Keep the most recent entries when resizing.
+	if l.core.retention != nil {
+		for _, e := range l.core.retention.snapshot() {
+			r.add(e)
+		}
+	}
+
+	l.core.retention = r
+}
+
+// supportBundle is the content of the support bundle file.
+type supportBundle struct {
+	GeneratedAt time.Time            `json:"generated_at"`
+	Config      supportBundleConfig  `json:"config"`
+	Entries     []supportBundleEntry `json:"entries"`
+}
+
+// supportBundleConfig summarizes the logger configuration.
+type supportBundleConfig struct {
+	Scope               string     `json:"scope"`
+	OutputLevel         LogLevel   `json:"output_level"`
+	EnabledLevels       []LogLevel `json:"enabled_levels,omitempty"`
+	JSONOutput          bool       `json:"json_output"`
+	SchemaVersion       string     `json:"schema_version"`
+	FieldKeyCase        string     `json:"field_key_case"`
+	FloatPrecision      int        `json:"float_precision"`
+	Coalescing          bool       `json:"coalescing"`
+	DegradationTracking bool       `json:"degradation_tracking"`
+	RetentionSize       int        `json:"retention_size"`
+}
+
+type supportBundleEntry struct {
+	Time    time.Time      `json:"time"`
+	Level   LogLevel       `json:"level"`
+	Scope   string         `json:"scope"`
+	Type    string         `json:"type"`
+	Message string         `json:"msg"`
+	Fields  map[string]any `json:"fields"`
+}
+
+// WriteSupportBundle writes to a JSON file at path a summary of the logger
+// configuration and the entries kept with EnableEntryRetention.
+func (l *daprLogger) WriteSupportBundle(path string) error {
+	l.core.lock.Lock()
+	bundle := supportBundle{
+		GeneratedAt: l.core.clock.Now(),
+		Config:      l.configSummaryLocked(),
+		Entries:     []supportBundleEntry{},
+	}
+	var entries []Entry
+	if l.core.retention != nil {
+		entries = l.core.retention.snapshot()
+	}
+	l.core.lock.Unlock()
+
+	for _, e := range entries {
+		bundle.Entries = append(bundle.Entries, supportBundleEntry(e))
+	}
+
+	b, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode support bundle: %w", err)
+	}
+
+	if err := os.WriteFile(path, b, 0o600); err != nil {
+		return fmt.Errorf("failed to write support bundle: %w", err)
+	}
+
+	return nil
+}
+
+func (l *daprLogger) configSummaryLocked() supportBundleConfig {
+	isJSON := isJSONFormatter(l.logger.Logger.Formatter)
+
+	cfg := supportBundleConfig{
+		Scope:               l.name,
+		OutputLevel:         fromLogrusLevel(l.logger.Logger.GetLevel()),
+		JSONOutput:          isJSON,
+		SchemaVersion:       *l.core.schemaVersion.Load(),
+		FloatPrecision:      int(l.core.floatPrecision.Load()),
+		Coalescing:          l.core.coalescer.Load() != nil,
+		DegradationTracking: l.core.degradation.Load() != nil,
+	}
+
+	switch FieldKeyCase(l.core.fieldKeyCase.Load()) {
+	case CaseSnake:
+		cfg.FieldKeyCase = "snake"
+	case CaseCamel:
+		cfg.FieldKeyCase = "camel"
+	default:
+		cfg.FieldKeyCase = "as_is"
+	}
+
+	if enabled := l.core.enabledLevels.Load(); enabled != 0 {
+		for lvl := logrus.PanicLevel; lvl <= logrus.TraceLevel; lvl++ {
+			if enabled&levelBit(lvl) != 0 {
+				cfg.EnabledLevels = append(cfg.EnabledLevels, fromLogrusLevel(lvl))
+			}
+		}
+	}
+
+	if l.core.retention != nil {
+		cfg.RetentionSize = l.core.retention.size
+	}
+
+	return cfg
+}
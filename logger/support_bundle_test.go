@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteSupportBundle(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.SetOutputLevel(DebugLevel)
+
+	readBundle := func(t *testing.T, path string) map[string]any {
+		t.Helper()
+
+		b, err := os.ReadFile(path)
+		require.NoError(t, err)
+
+		var o map[string]any
+		require.NoError(t, json.Unmarshal(b, &o))
+
+		return o
+	}
+
+	t.Run("without retention", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "bundle.json")
+		testLogger.Info("not retained")
+		require.NoError(t, testLogger.WriteSupportBundle(path))
+
+		o := readBundle(t, path)
+		assert.Empty(t, o["entries"])
+		assert.Contains(t, o, "config")
+	})
+
+	t.Run("retained entries", func(t *testing.T) {
+		testLogger.EnableEntryRetention(2)
+		testLogger.Info("first")
+		testLogger.WithFields(map[string]any{"attempt": 2}).Warn("second")
+		testLogger.Debug("third")
+
+		path := filepath.Join(t.TempDir(), "bundle.json")
+		require.NoError(t, testLogger.WriteSupportBundle(path))
+
+		o := readBundle(t, path)
+
+		entries, ok := o["entries"].([]any)
+		require.True(t, ok)
+		require.Len(t, entries, 2)
+
+		second := entries[0].(map[string]any)
+		assert.Equal(t, "second", second["msg"])
+		assert.Equal(t, "warn", second["level"])
+		assert.Equal(t, fakeLoggerName, second["scope"])
+		assert.InDelta(t, float64(2), second["fields"].(map[string]any)["attempt"], 0)
+
+		third := entries[1].(map[string]any)
+		assert.Equal(t, "third", third["msg"])
+		assert.Equal(t, "debug", third["level"])
+
+		cfg, ok := o["config"].(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, fakeLoggerName, cfg["scope"])
+		assert.Equal(t, "debug", cfg["output_level"])
+		assert.Equal(t, true, cfg["json_output"])
+		assert.Equal(t, DefaultSchemaVersion, cfg["schema_version"])
+		assert.InDelta(t, float64(2), cfg["retention_size"], 0)
+	})
+
+	t.Run("invalid path", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "missing", "bundle.json")
+		require.Error(t, testLogger.WriteSupportBundle(path))
+	})
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	kclock "k8s.io/utils/clock"
+)
+
+const (
+	defaultSyslogPort = "514"
+
+	syslogTimeFormat = "2006-01-02T15:04:05.000000Z07:00"
+
+	// syslogMinRetryInterval and syslogMaxRetryInterval bound the exponential
+	// backoff between the attempts to reconnect to a lost syslog server.
+	syslogMinRetryInterval = 500 * time.Millisecond
+	syslogMaxRetryInterval = 30 * time.Second
+)
+
+// Syslog facilities accepted in the facility parameter of a syslog target.
+var syslogFacilities = map[string]int{
+	"kern":   0,
+	"user":   1,
+	"daemon": 3,
+	"auth":   4,
+	"local0": 16,
+	"local1": 17,
+	"local2": 18,
+	"local3": 19,
+	"local4": 20,
+	"local5": 21,
+	"local6": 22,
+	"local7": 23,
+}
+
+// SyslogWriter writes log entries as RFC 5424 syslog messages to a syslog
+// server, with the severity of the entry level.
+type SyslogWriter struct {
+	network  string
+	address  string
+	facility int
+	hostname string
+	appName  string
+	procID   string
+	clock    kclock.PassiveClock
+
+	lock sync.Mutex
+	// conn is nil once Close is called, or while the connection is lost
+	conn   net.Conn
+	closed bool
+	// retryAt is when to try to reconnect after retryInterval, while the
+	// connection is lost
+	retryAt       time.Time
+	retryInterval time.Duration
+}
+
+// NewSyslogWriter returns a SyslogWriter connected to the syslog server of
+// target, such as:
+//
+//	syslog://host:514              UDP, the port defaults to 514
+//	syslog+tcp://host:601          TCP, with octet-counting framing
+//	syslog+unix:///dev/log         unix socket
+//
+// The facility defaults to daemon and can be set with the facility parameter,
+// such as syslog://host:514?facility=local0.
+func NewSyslogWriter(target string) (*SyslogWriter, error) {
+	return newSyslogWriter(target, kclock.RealClock{})
+}
+
+func newSyslogWriter(target string, clock kclock.PassiveClock) (*SyslogWriter, error) {
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, fmt.Errorf("invalid syslog target %q: %w", target, err)
+	}
+
+	w := &SyslogWriter{
+		facility: syslogFacilities["daemon"],
+		hostname: "-",
+		appName:  filepath.Base(os.Args[0]),
+		procID:   strconv.Itoa(os.Getpid()),
+		clock:    clock,
+	}
+
+	switch u.Scheme {
+	case "syslog", "syslog+udp":
+		w.network, w.address = "udp", syslogHostPort(u)
+	case "syslog+tcp":
+		w.network, w.address = "tcp", syslogHostPort(u)
+	case "syslog+unix":
+		w.network, w.address = "unixgram", u.Path
+	default:
+		return nil, fmt.Errorf("invalid syslog target %q: unsupported scheme %q", target, u.Scheme)
+	}
+
+	if name := u.Query().Get("facility"); name != "" {
+		facility, ok := syslogFacilities[name]
+		if !ok {
+			return nil, fmt.Errorf("invalid syslog target %q: unknown facility %q", target, name)
+		}
+		w.facility = facility
+	}
+
+	if hostname, herr := os.Hostname(); herr == nil && hostname != "" {
+		w.hostname = hostname
+	}
+
+	if err = w.connectLocked(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func syslogHostPort(u *url.URL) string {
+	if u.Port() == "" {
+		return net.JoinHostPort(u.Hostname(), defaultSyslogPort)
+	}
+
+	return u.Host
+}
+
+func (w *SyslogWriter) connectLocked() error {
+	conn, err := net.Dial(w.network, w.address)
+	if err != nil && w.network == "unixgram" {
+		// Some syslog daemons listen on a stream socket.
+		conn, err = net.Dial("unix", w.address)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to connect to syslog at %s: %w", w.address, err)
+	}
+
+	w.conn = conn
+
+	return nil
+}
+
+// Write sends p as a message with the severity of level Info.
+func (w *SyslogWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(InfoLevel, p)
+}
+
+// WriteLevel sends p as a message with the severity of lvl, reconnecting once
+// if the connection to the server was lost. If reconnecting fails, the
+// messages are dropped with an error until a later write reconnects, attempted
+// with an exponential backoff.
+func (w *SyslogWriter) WriteLevel(lvl LogLevel, p []byte) (int, error) {
+	msg := w.message(lvl, p)
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.closed {
+		return 0, ErrWriterClosed
+	}
+
+	if w.conn == nil {
+		if err := w.reconnectLocked(); err != nil {
+			return 0, err
+		}
+	} else if _, err := w.conn.Write(msg); err == nil {
+		return len(p), nil
+	} else {
+		w.conn.Close()
+		w.conn = nil
+		if err = w.reconnectLocked(); err != nil {
+			return 0, err
+		}
+	}
+
+	if _, err := w.conn.Write(msg); err != nil {
+		w.conn.Close()
+		w.conn = nil
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// reconnectLocked connects to the server again unless the backoff since the
+// last failed attempt hasn't elapsed. The caller must hold lock.
+func (w *SyslogWriter) reconnectLocked() error {
+	now := w.clock.Now()
+	if now.Before(w.retryAt) {
+		return fmt.Errorf("syslog at %s unavailable, retrying in %v", w.address, w.retryAt.Sub(now))
+	}
+
+	if err := w.connectLocked(); err != nil {
+		w.retryInterval = min(max(2*w.retryInterval, syslogMinRetryInterval), syslogMaxRetryInterval)
+		w.retryAt = now.Add(w.retryInterval)
+		return err
+	}
+
+	w.retryAt = time.Time{}
+	w.retryInterval = 0
+
+	return nil
+}
+
+// message returns p as an RFC 5424 message, framed with its length over TCP.
+func (w *SyslogWriter) message(lvl LogLevel, p []byte) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "<%d>1 %s %s %s %s - - ",
+		w.facility*8+syslogSeverity(lvl),
+		w.clock.Now().Format(syslogTimeFormat),
+		w.hostname, w.appName, w.procID,
+	)
+	buf.Write(bytes.TrimRight(p, "\n"))
+
+	if w.network != "tcp" {
+		return buf.Bytes()
+	}
+
+	return append([]byte(strconv.Itoa(buf.Len())+" "), buf.Bytes()...)
+}
+
+// Close closes the connection to the syslog server.
+func (w *SyslogWriter) Close() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.closed = true
+	if w.conn == nil {
+		return nil
+	}
+
+	err := w.conn.Close()
+	w.conn = nil
+
+	return err
+}
+
+// syslogSeverity maps a LogLevel to a syslog severity.
+func syslogSeverity(lvl LogLevel) int {
+	switch lvl {
+	case TraceLevel, DebugLevel:
+		return 7
+	case InfoLevel:
+		return 6
+	case WarnLevel:
+		return 4
+	case ErrorLevel:
+		return 3
+	case FatalLevel:
+		return 2
+	case PanicLevel:
+		return 1
+	default:
+		return 5
+	}
+}
+
+// isSyslogTarget returns true if target is a syslog URL.
+func isSyslogTarget(target string) bool {
+	return strings.HasPrefix(target, "syslog://") || strings.HasPrefix(target, "syslog+")
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func readUDPMessage(t *testing.T, conn net.PacketConn) string {
+	t.Helper()
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+
+	b := make([]byte, 64*1024)
+	n, _, err := conn.ReadFrom(b)
+	require.NoError(t, err)
+
+	return string(b[:n])
+}
+
+func TestSyslogWriter(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 30, 0, 0, time.UTC)
+	clock := clocktesting.NewFakePassiveClock(now)
+	hostname, _ := os.Hostname()
+	header := func(pri int) string {
+		return fmt.Sprintf("<%d>1 2026-03-01T10:30:00.000000Z %s %s %d - - ",
+			pri, hostname, filepath.Base(os.Args[0]), os.Getpid())
+	}
+
+	t.Run("udp", func(t *testing.T) {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+
+		w, err := newSyslogWriter("syslog://"+conn.LocalAddr().String(), clock)
+		require.NoError(t, err)
+		t.Cleanup(func() { w.Close() })
+
+		n, err := w.WriteLevel(ErrorLevel, []byte("something failed\n"))
+		require.NoError(t, err)
+		assert.Equal(t, len("something failed\n"), n)
+
+		// daemon (3) * 8 + err (3)
+		assert.Equal(t, header(27)+"something failed", readUDPMessage(t, conn))
+
+		_, err = w.Write([]byte("no level"))
+		require.NoError(t, err)
+		assert.Equal(t, header(30)+"no level", readUDPMessage(t, conn))
+	})
+
+	t.Run("tcp with facility", func(t *testing.T) {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		t.Cleanup(func() { ln.Close() })
+
+		received := make(chan string, 1)
+		go func() {
+			conn, aerr := ln.Accept()
+			if aerr != nil {
+				return
+			}
+			defer conn.Close()
+
+			r := bufio.NewReader(conn)
+			length, _ := r.ReadString(' ')
+			size, _ := strconv.Atoi(strings.TrimSpace(length))
+			msg := make([]byte, size)
+			_, _ = io.ReadFull(r, msg)
+			received <- string(msg)
+		}()
+
+		w, err := newSyslogWriter("syslog+tcp://"+ln.Addr().String()+"?facility=local0", clock)
+		require.NoError(t, err)
+		t.Cleanup(func() { w.Close() })
+
+		_, err = w.WriteLevel(WarnLevel, []byte("careful"))
+		require.NoError(t, err)
+
+		select {
+		case msg := <-received:
+			// local0 (16) * 8 + warning (4)
+			assert.Equal(t, header(132)+"careful", msg)
+		case <-time.After(5 * time.Second):
+			t.Fatal("message not received")
+		}
+	})
+
+	t.Run("unix", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("unix datagram sockets are not supported on windows")
+		}
+
+		path := filepath.Join(t.TempDir(), "log.sock")
+		conn, err := net.ListenPacket("unixgram", path)
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+
+		w, err := newSyslogWriter("syslog+unix://"+path, clock)
+		require.NoError(t, err)
+		t.Cleanup(func() { w.Close() })
+
+		_, err = w.WriteLevel(DebugLevel, []byte("details"))
+		require.NoError(t, err)
+		assert.Equal(t, header(31)+"details", readUDPMessage(t, conn))
+	})
+
+	t.Run("invalid targets", func(t *testing.T) {
+		for _, target := range []string{
+			"http://host:514",
+			"syslog://host:514?facility=nope",
+			"syslog+tcp://127.0.0.1:1",
+		} {
+			_, err := NewSyslogWriter(target)
+			require.Error(t, err, target)
+		}
+	})
+
+	t.Run("reconnects after an outage", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("unix datagram sockets are not supported on windows")
+		}
+
+		clock := clocktesting.NewFakePassiveClock(now)
+		path := filepath.Join(t.TempDir(), "log.sock")
+		conn, err := net.ListenPacket("unixgram", path)
+		require.NoError(t, err)
+
+		w, err := newSyslogWriter("syslog+unix://"+path, clock)
+		require.NoError(t, err)
+		t.Cleanup(func() { w.Close() })
+
+		// The server goes away, so the write and the reconnection fail.
+		conn.Close()
+		os.Remove(path)
+		_, err = w.Write([]byte("lost"))
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrWriterClosed)
+
+		conn, err = net.ListenPacket("unixgram", path)
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+
+		// The reconnection waits for the backoff.
+		_, err = w.Write([]byte("too early"))
+		require.ErrorContains(t, err, "retrying in")
+
+		clock.SetTime(now.Add(syslogMinRetryInterval))
+		_, err = w.Write([]byte("back"))
+		require.NoError(t, err)
+		assert.True(t, strings.HasSuffix(readUDPMessage(t, conn), " - - back"))
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+
+		w, err := NewSyslogWriter("syslog://" + conn.LocalAddr().String())
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		_, err = w.Write([]byte("dropped"))
+		require.ErrorIs(t, err, ErrWriterClosed)
+	})
+}
+
+func TestSyslogWriterLevels(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	w, err := NewSyslogWriter("syslog://" + conn.LocalAddr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() { w.Close() })
+
+	var buf bytes.Buffer
+	testLogger := getTestLogger(&buf)
+	testLogger.SetOutput(w)
+
+	testLogger.Warn("from the logger")
+
+	msg := readUDPMessage(t, conn)
+	assert.True(t, strings.HasPrefix(msg, "<28>1 "), msg)
+	assert.Contains(t, msg, "from the logger")
+	assert.False(t, strings.HasSuffix(msg, "\n"))
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"io"
+)
+
+const logFieldTenantID = "tenant_id"
+
+// WithTenant returns a logger whose entries carry tenantID in the tenant_id
+// field, and are written to the tenant sink when tenant routing is set.
+func (l *daprLogger) WithTenant(tenantID string) Logger {
+	return l.derive(l.logger.WithField(logFieldTenantID, tenantID))
+}
+
+// SetTenantRouting sets the function returning the destination of the entries
+// of a tenant. Entries are written to the output when route returns nil.
+// route is called while writing the entry, so it must not log.
+// A nil route disables the routing.
+func (l *daprLogger) SetTenantRouting(route func(tenantID string) io.Writer) {
+	l.core.lock.Lock()
+	defer l.core.lock.Unlock()
+
+	l.core.tenantRouting = route
+}
+
+// tenantOutputLocked returns the tenant sink of the entry with data, or nil if
+// none. It must be called with the core lock held.
+func (l *daprLogger) tenantOutputLocked(data map[string]any) io.Writer {
+	if l.core.tenantRouting == nil {
+		return nil
+	}
+
+	if id, ok := data[logFieldTenantID].(string); ok {
+		return l.core.tenantRouting(id)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithTenant(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	t.Run("tenant field", func(t *testing.T) {
+		testLogger.WithTenant("acme").Info("hello")
+
+		o := readTestEntry(t, &buf)
+		buf.Reset()
+
+		assert.Equal(t, "acme", o[logFieldTenantID])
+	})
+
+	t.Run("tenant routing", func(t *testing.T) {
+		sinks := map[string]*bytes.Buffer{
+			"acme":   {},
+			"globex": {},
+		}
+		testLogger.SetTenantRouting(func(tenantID string) io.Writer {
+			if sink, ok := sinks[tenantID]; ok {
+				return sink
+			}
+			return nil
+		})
+		t.Cleanup(func() { testLogger.SetTenantRouting(nil) })
+
+		testLogger.WithTenant("acme").Info("from acme")
+		testLogger.WithTenant("globex").Info("from globex")
+
+		for tenantID, sink := range sinks {
+			var o map[string]any
+			require.NoError(t, json.Unmarshal(sink.Bytes(), &o))
+
+			assert.Equal(t, tenantID, o[logFieldTenantID])
+			assert.Equal(t, "from "+tenantID, o[logFieldMessage])
+		}
+		assert.Zero(t, buf.Len())
+
+		// Entries without a tenant sink keep going to the output.
+		testLogger.WithTenant("initech").Info("from initech")
+		testLogger.Info("no tenant")
+		assert.Equal(t, 2, bytes.Count(buf.Bytes(), []byte{'\n'}))
+	})
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"time"
+)
+
+// DefaultTimestampFormat is the default layout of the time field.
+const DefaultTimestampFormat = time.RFC3339Nano
+
+// SetTimestampFormat sets the layout of the time field, for example
+// time.RFC3339, for both the JSON and text output. An empty layout restores
+// DefaultTimestampFormat. If utc is true, the time is converted to UTC instead
+// of being written in the local time zone.
+func (l *daprLogger) SetTimestampFormat(layout string, utc bool) {
+	if layout == "" {
+		layout = DefaultTimestampFormat
+	}
+
+	l.core.utc.Store(utc)
+
+	l.core.lock.Lock()
+	defer l.core.lock.Unlock()
+
+	l.core.timestampFormat = layout
+	l.logger.Logger.SetFormatter(l.newFormatterLocked(isJSONFormatter(l.logger.Logger.Formatter)))
+	for i := range l.core.sinks {
+		l.core.sinks[i].formatter = l.core.sinks[i].newFormatter(layout)
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestSetTimestampFormat(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 30, 0, 123456789, time.FixedZone("CET", 3600))
+
+	t.Run("default format", func(t *testing.T) {
+		var buf bytes.Buffer
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+		testLogger.core.clock = clocktesting.NewFakeClock(now)
+
+		testLogger.Info("default")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "2026-03-01T12:30:00.123456789+01:00", o[logFieldTimeStamp])
+	})
+
+	t.Run("json in utc", func(t *testing.T) {
+		var buf bytes.Buffer
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+		testLogger.core.clock = clocktesting.NewFakeClock(now)
+
+		testLogger.SetTimestampFormat(time.RFC3339, true)
+		testLogger.Info("utc")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "2026-03-01T11:30:00Z", o[logFieldTimeStamp])
+	})
+
+	t.Run("text keeps the format", func(t *testing.T) {
+		var buf bytes.Buffer
+		testLogger := getTestLogger(&buf)
+		testLogger.core.clock = clocktesting.NewFakeClock(now)
+
+		testLogger.SetTimestampFormat(time.Kitchen, false)
+		// Switching the output format keeps the timestamp format.
+		testLogger.EnableJSONOutput(false)
+		testLogger.Info("text")
+
+		assert.Contains(t, buf.String(), "time=\"12:30PM\"")
+	})
+
+	t.Run("empty layout restores the default", func(t *testing.T) {
+		var buf bytes.Buffer
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+		testLogger.core.clock = clocktesting.NewFakeClock(now)
+
+		testLogger.SetTimestampFormat(time.Kitchen, true)
+		testLogger.SetTimestampFormat("", true)
+		testLogger.Info("default")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "2026-03-01T11:30:00.123456789Z", o[logFieldTimeStamp])
+	})
+}
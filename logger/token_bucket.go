@@ -0,0 +1,32 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+const (
+	logFieldBucketName     = "bucket_name"
+	logFieldBucketTokens   = "bucket_tokens"
+	logFieldBucketCapacity = "bucket_capacity"
+	logFieldBucketRefill   = "bucket_refill"
+)
+
+// WithTokenBucket returns a logger with the state of the token bucket name:
+// the available tokens, its capacity and its refill rate per second.
+func (l *daprLogger) WithTokenBucket(name string, tokens, capacity float64, refillPerSec float64) Logger {
+	return l.WithFields(map[string]any{
+		logFieldBucketName:     name,
+		logFieldBucketTokens:   tokens,
+		logFieldBucketCapacity: capacity,
+		logFieldBucketRefill:   refillPerSec,
+	})
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithTokenBucket(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+
+	testLogger.WithTokenBucket("api", 2.5, 10, 0.5).Info("request throttled")
+
+	o := readTestEntry(t, &buf)
+
+	assert.Equal(t, "api", o[logFieldBucketName])
+	assert.InDelta(t, 2.5, o[logFieldBucketTokens], 0)
+	assert.InDelta(t, float64(10), o[logFieldBucketCapacity], 0)
+	assert.InDelta(t, 0.5, o[logFieldBucketRefill], 0)
+}
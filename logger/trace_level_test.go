@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraceLevel(t *testing.T) {
+	t.Run("trace is below debug", func(t *testing.T) {
+		var buf bytes.Buffer
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+
+		testLogger.SetOutputLevel(DebugLevel)
+		testLogger.Trace("dropped")
+		assert.False(t, testLogger.IsOutputLevelEnabled(TraceLevel))
+		assert.Empty(t, buf.String())
+
+		testLogger.SetOutputLevel(TraceLevel)
+		assert.True(t, testLogger.IsOutputLevelEnabled(TraceLevel))
+		assert.True(t, testLogger.IsOutputLevelEnabled(DebugLevel))
+		testLogger.Tracef("frame %d", 1)
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "trace", o[logFieldLevel])
+		assert.Equal(t, "frame 1", o[logFieldMessage])
+	})
+
+	t.Run("parse trace", func(t *testing.T) {
+		assert.Equal(t, TraceLevel, toLogLevel("TRACE"))
+		assert.Equal(t, TraceLevel, fromLogrusLevel(toLogrusLevel(TraceLevel)))
+
+		l := getTestLogger(&bytes.Buffer{})
+		l.SetOutputLevel(TraceLevel)
+		assert.Equal(t, TraceLevel, outputLevel(l))
+	})
+
+	t.Run("slog levels", func(t *testing.T) {
+		assert.Equal(t, TraceLevel, fromSlogLevel(toSlogLevel(TraceLevel)))
+		assert.Equal(t, DebugLevel, fromSlogLevel(slog.LevelDebug))
+	})
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"time"
+)
+
+const (
+	logFieldRequestID        = "request_id"
+	logFieldValidationPassed = "validation_passed"
+	logFieldValidationErrors = "validation_errors"
+)
+
+// FieldError is a validation error of a request field.
+type FieldError struct {
+	// Field is the path of the invalid field.
+	Field string `json:"field"`
+	// Message describes why the field is invalid.
+	Message string `json:"message"`
+}
+
+// LogValidation logs the outcome of validating the request with the given ID.
+// Passed validations are logged at level Debug, failed ones at level Warn with
+// the validation errors.
+func (l *daprLogger) LogValidation(requestID string, valid bool, errs []FieldError, duration time.Duration) {
+	fields := map[string]any{
+		logFieldRequestID:        requestID,
+		logFieldValidationPassed: valid,
+		logFieldDurationMs:       toMilliseconds(duration),
+	}
+
+	if !valid {
+		fields[logFieldValidationErrors] = errs
+		l.WithFields(fields).Warnf("Request %s failed validation", requestID)
+		return
+	}
+
+	l.WithFields(fields).Debugf("Request %s passed validation", requestID)
+}
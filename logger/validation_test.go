@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogValidation(t *testing.T) {
+	t.Run("passed", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+		testLogger.SetOutputLevel(DebugLevel)
+
+		testLogger.LogValidation("req-1", true, nil, 3*time.Millisecond)
+
+		o := readTestEntry(t, &buf)
+
+		assert.Equal(t, "debug", o[logFieldLevel])
+		assert.Equal(t, "req-1", o[logFieldRequestID])
+		assert.Equal(t, true, o[logFieldValidationPassed])
+		assert.InDelta(t, float64(3), o[logFieldDurationMs], 0.001)
+		assert.NotContains(t, o, logFieldValidationErrors)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		testLogger := getTestLogger(&buf)
+		testLogger.EnableJSONOutput(true)
+
+		testLogger.LogValidation("req-2", false, []FieldError{
+			{Field: "metadata.ttl", Message: "must be positive"},
+			{Field: "key", Message: "is required"},
+		}, 5*time.Millisecond)
+
+		o := readTestEntry(t, &buf)
+
+		assert.Equal(t, "warning", o[logFieldLevel])
+		assert.Equal(t, "req-2", o[logFieldRequestID])
+		assert.Equal(t, false, o[logFieldValidationPassed])
+		assert.Equal(t, []any{
+			map[string]any{"field": "metadata.ttl", "message": "must be positive"},
+			map[string]any{"field": "key", "message": "is required"},
+		}, o[logFieldValidationErrors])
+	})
+}
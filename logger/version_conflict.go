@@ -0,0 +1,37 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	logFieldExpectedVersion = "expected_version"
+	logFieldActualVersion   = "actual_version"
+)
+
+// WithVersionConflict returns a logger for an optimistic concurrency conflict
+// on resource, with the expected and actual versions. Its entries are logged
+// at least at level Warn, so Info and Debug entries are raised to Warn.
+func (l *daprLogger) WithVersionConflict(resource string, expected, actual int64) Logger {
+	dl := l.derive(l.logger.WithFields(logrus.Fields{
+		logFieldResource:        resource,
+		logFieldExpectedVersion: expected,
+		logFieldActualVersion:   actual,
+	}))
+	dl.minSeverity = dl.raiseLevel(logrus.WarnLevel)
+
+	return dl
+}
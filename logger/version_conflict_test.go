@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithVersionConflict(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.SetOutputLevel(WarnLevel)
+
+	conflictLogger := testLogger.WithVersionConflict("orders||42", 7, 9)
+
+	t.Run("fields at level Warn", func(t *testing.T) {
+		conflictLogger.Info("Version conflict, retrying")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "warning", o[logFieldLevel])
+		assert.Equal(t, "orders||42", o[logFieldResource])
+		assert.InDelta(t, float64(7), o[logFieldExpectedVersion], 0)
+		assert.InDelta(t, float64(9), o[logFieldActualVersion], 0)
+	})
+
+	t.Run("derived logger", func(t *testing.T) {
+		conflictLogger.WithFields(map[string]any{"attempt": 2}).Debugf("Version conflict, retrying")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "warning", o[logFieldLevel])
+		assert.InDelta(t, float64(2), o["attempt"], 0)
+	})
+
+	t.Run("more severe levels are kept", func(t *testing.T) {
+		conflictLogger.Error("Version conflict, giving up")
+
+		o := readTestEntry(t, &buf)
+		assert.Equal(t, "error", o[logFieldLevel])
+	})
+
+	t.Run("parent logger is unchanged", func(t *testing.T) {
+		testLogger.Info("not raised")
+
+		assert.Zero(t, buf.Len())
+	})
+}
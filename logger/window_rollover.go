@@ -0,0 +1,38 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"time"
+)
+
+const (
+	logFieldLimiter = "limiter"
+	logFieldWindow  = "window"
+)
+
+// LogWindowRollover logs at level Debug that the window of the rate limiter
+// limiter rolled over, with the bounds of the window that ended and the number
+// of requests allowed and denied within it under the window field.
+func (l *daprLogger) LogWindowRollover(limiter string, windowStart, windowEnd time.Time, allowed, denied int64) {
+	l.WithFields(map[string]any{
+		logFieldLimiter: limiter,
+		logFieldWindow: map[string]any{
+			"start":   windowStart.Format(time.RFC3339Nano),
+			"end":     windowEnd.Format(time.RFC3339Nano),
+			"allowed": allowed,
+			"denied":  denied,
+		},
+	}).Debugf("Rate limiter %s window rolled over: %d allowed, %d denied", limiter, allowed, denied)
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogWindowRollover(t *testing.T) {
+	var buf bytes.Buffer
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.SetOutputLevel(DebugLevel)
+
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	testLogger.LogWindowRollover("api", start, start.Add(time.Minute), 95, 5)
+
+	o := readTestEntry(t, &buf)
+
+	assert.Equal(t, "debug", o[logFieldLevel])
+	assert.Equal(t, "api", o[logFieldLimiter])
+
+	window, ok := o[logFieldWindow].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "2026-03-01T12:00:00Z", window["start"])
+	assert.Equal(t, "2026-03-01T12:01:00Z", window["end"])
+	assert.InDelta(t, float64(95), window["allowed"], 0)
+	assert.InDelta(t, float64(5), window["denied"], 0)
+}
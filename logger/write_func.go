@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"fmt"
+	"os"
+)
+
+// SetWriteFunc sets the function writing the entries, in place of formatting
+// them and writing them to the output, the channels or the tenant and audited
+// sinks. It is called with the entries enabled by the logger levels, one at a
+// time. Passing nil restores the output.
+func (l *daprLogger) SetWriteFunc(fn func(Entry) error) {
+	l.core.lock.Lock()
+	defer l.core.lock.Unlock()
+
+	l.core.writeFunc = fn
+}
+
+// writeFuncLocked writes entry with the write func.
+// It must be called with the core lock held.
+func (l *daprLogger) writeFuncLocked(e Entry) error {
+	err := l.core.writeFunc(e)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write to log, %v\n", err)
+	}
+
+	if l.core.retention != nil {
+		l.core.retention.add(e)
+	}
+
+	return err
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetWriteFunc(t *testing.T) {
+	var (
+		buf     bytes.Buffer
+		entries []Entry
+	)
+
+	testLogger := getTestLogger(&buf)
+	testLogger.EnableJSONOutput(true)
+	testLogger.SetOutputLevel(InfoLevel)
+	testLogger.SetWriteFunc(func(e Entry) error {
+		entries = append(entries, e)
+		return nil
+	})
+
+	t.Run("entries", func(t *testing.T) {
+		testLogger.Debug("filtered")
+		testLogger.WithFields(map[string]any{"attempt": 2}).Warn("retrying")
+		testLogger.WithLogType(LogTypeRequest).Info("request")
+
+		require.Len(t, entries, 2)
+
+		assert.Equal(t, WarnLevel, entries[0].Level)
+		assert.Equal(t, "retrying", entries[0].Message)
+		assert.Equal(t, fakeLoggerName, entries[0].Scope)
+		assert.Equal(t, LogTypeLog, entries[0].Type)
+		assert.Equal(t, 2, entries[0].Fields["attempt"])
+		assert.False(t, entries[0].Time.IsZero())
+
+		assert.Equal(t, InfoLevel, entries[1].Level)
+		assert.Equal(t, LogTypeRequest, entries[1].Type)
+
+		// The output is bypassed.
+		assert.Zero(t, buf.Len())
+	})
+
+	t.Run("write error", func(t *testing.T) {
+		writeErr := errors.New("backend unavailable")
+		testLogger.SetWriteFunc(func(Entry) error { return writeErr })
+
+		var gotErr error
+		testLogger.OnWrite(func(_ Entry, _ int, err error) { gotErr = err })
+		testLogger.Info("lost")
+
+		require.ErrorIs(t, gotErr, writeErr)
+	})
+
+	t.Run("output restored", func(t *testing.T) {
+		testLogger.SetWriteFunc(nil)
+		testLogger.Info("written")
+
+		assert.Contains(t, buf.String(), "written")
+	})
+}